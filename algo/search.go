@@ -0,0 +1,41 @@
+// Package algo implements generic searching, sorting and graph algorithms.
+package algo
+
+import "cmp"
+
+// BinarySearch searches the ascending slice s for target. It returns the
+// index of the first element equal to target and true, or the index at which
+// target would be inserted and false if it is not present.
+func BinarySearch[T cmp.Ordered](s []T, target T) (int, bool) {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if s[mid] < target {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(s) && s[lo] == target
+}
+
+// InsertSorted inserts v into the ascending slice s, keeping it sorted, and
+// returns the updated slice. v is placed after any elements equal to it. As
+// with append, the result may share s's underlying array.
+func InsertSorted[T cmp.Ordered](s []T, v T) []T {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if s[mid] <= v {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	var zero T
+	s = append(s, zero)
+	copy(s[lo+1:], s[lo:])
+	s[lo] = v
+	return s
+}
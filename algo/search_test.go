@@ -0,0 +1,57 @@
+package algo
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBinarySearch(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      []int
+		target int
+		idx    int
+		found  bool
+	}{
+		{"empty", nil, 3, 0, false},
+		{"single hit", []int{3}, 3, 0, true},
+		{"single miss", []int{3}, 4, 1, false},
+		{"duplicates return first", []int{1, 2, 2, 2, 3}, 2, 1, true},
+		{"all duplicates", []int{7, 7, 7}, 7, 0, true},
+		{"below all", []int{10, 20, 30}, 5, 0, false},
+		{"above all", []int{10, 20, 30}, 35, 3, false},
+		{"between", []int{10, 20, 30}, 25, 2, false},
+		{"last", []int{10, 20, 30}, 30, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx, found := BinarySearch(tt.s, tt.target)
+			if idx != tt.idx || found != tt.found {
+				t.Fatalf("BinarySearch(%v, %d) = %d, %v; want %d, %v",
+					tt.s, tt.target, idx, found, tt.idx, tt.found)
+			}
+		})
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []int
+		v    int
+		want []int
+	}{
+		{"empty", nil, 5, []int{5}},
+		{"front", []int{2, 4}, 1, []int{1, 2, 4}},
+		{"middle", []int{2, 4}, 3, []int{2, 3, 4}},
+		{"back", []int{2, 4}, 9, []int{2, 4, 9}},
+		{"duplicate", []int{2, 4, 4, 6}, 4, []int{2, 4, 4, 4, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InsertSorted(slices.Clone(tt.s), tt.v); !slices.Equal(got, tt.want) {
+				t.Fatalf("InsertSorted(%v, %d) = %v, want %v", tt.s, tt.v, got, tt.want)
+			}
+		})
+	}
+}
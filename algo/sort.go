@@ -0,0 +1,128 @@
+package algo
+
+import "cmp"
+
+// MergeSort returns a sorted copy of s in ascending order, leaving s
+// unchanged. The sort is stable.
+func MergeSort[T cmp.Ordered](s []T) []T {
+	return MergeSortFunc(s, func(a, b T) bool { return a < b })
+}
+
+// MergeSortFunc returns a copy of s sorted by less, leaving s unchanged.
+// The sort is stable: elements that compare equal keep their original
+// relative order. It runs in O(n log n) time and uses O(n) extra space.
+func MergeSortFunc[T any](s []T, less func(a, b T) bool) []T {
+	out := make([]T, len(s))
+	copy(out, s)
+	if len(out) < 2 {
+		return out
+	}
+
+	buf := make([]T, len(out))
+	mergeSort(out, buf, less)
+	return out
+}
+
+// mergeSort sorts s in place using buf, which must be at least as long as s,
+// as scratch space.
+func mergeSort[T any](s, buf []T, less func(a, b T) bool) {
+	if len(s) < 2 {
+		return
+	}
+
+	mid := len(s) / 2
+	mergeSort(s[:mid], buf[:mid], less)
+	mergeSort(s[mid:], buf[mid:], less)
+
+	// Already in order; common for nearly sorted input.
+	if !less(s[mid], s[mid-1]) {
+		return
+	}
+
+	copy(buf, s)
+	left, right := buf[:mid], buf[mid:len(s)]
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		// Taking from left on ties is what keeps the sort stable.
+		if less(right[j], left[i]) {
+			s[k] = right[j]
+			j++
+		} else {
+			s[k] = left[i]
+			i++
+		}
+		k++
+	}
+	k += copy(s[k:], left[i:])
+	copy(s[k:], right[j:])
+}
+
+// QuickSort sorts s in place in ascending order. The sort is not stable.
+func QuickSort[T cmp.Ordered](s []T) {
+	QuickSortFunc(s, func(a, b T) bool { return a < b })
+}
+
+// QuickSortFunc sorts s in place by less. The sort is not stable: elements
+// that compare equal may be reordered. Pivots are chosen by median of
+// three and elements equal to the pivot are grouped together, so sorted,
+// reverse-sorted and duplicate-heavy input all sort in O(n log n).
+func QuickSortFunc[T any](s []T, less func(a, b T) bool) {
+	for len(s) > insertionSortMax {
+		lt, gt := partition(s, less)
+		// Recurse into the smaller side and loop on the larger one to keep
+		// the stack depth logarithmic.
+		if lt < len(s)-gt {
+			QuickSortFunc(s[:lt], less)
+			s = s[gt:]
+		} else {
+			QuickSortFunc(s[gt:], less)
+			s = s[:lt]
+		}
+	}
+	insertionSort(s, less)
+}
+
+// insertionSortMax is the slice length at or below which QuickSortFunc
+// switches to insertion sort.
+const insertionSortMax = 12
+
+// partition rearranges s around a median-of-three pivot into three runs:
+// s[:lt] is less than the pivot, s[lt:gt] is equal to it and s[gt:] is
+// greater.
+func partition[T any](s []T, less func(a, b T) bool) (lt, gt int) {
+	lo, mid, hi := 0, len(s)/2, len(s)-1
+	if less(s[mid], s[lo]) {
+		s[mid], s[lo] = s[lo], s[mid]
+	}
+	if less(s[hi], s[lo]) {
+		s[hi], s[lo] = s[lo], s[hi]
+	}
+	if less(s[hi], s[mid]) {
+		s[hi], s[mid] = s[mid], s[hi]
+	}
+	pivot := s[mid]
+
+	lt, i, gt := 0, 0, len(s)
+	for i < gt {
+		switch {
+		case less(s[i], pivot):
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case less(pivot, s[i]):
+			gt--
+			s[i], s[gt] = s[gt], s[i]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+func insertionSort[T any](s []T, less func(a, b T) bool) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && less(s[j], s[j-1]); j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package algo
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func sortedCopy(s []int) []int {
+	out := slices.Clone(s)
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func TestSortRandomized(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for n := 0; n < 200; n++ {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = rng.Intn(50) - 25
+		}
+		want := sortedCopy(s)
+
+		orig := slices.Clone(s)
+		if got := MergeSort(s); !slices.Equal(got, want) {
+			t.Fatalf("MergeSort(%v) = %v, want %v", s, got, want)
+		}
+		if !slices.Equal(s, orig) {
+			t.Fatal("MergeSort modified its input")
+		}
+
+		q := slices.Clone(s)
+		QuickSort(q)
+		if !slices.Equal(q, want) {
+			t.Fatalf("QuickSort(%v) = %v, want %v", s, q, want)
+		}
+	}
+}
+
+func TestSortShapes(t *testing.T) {
+	ascending := make([]int, 1000)
+	for i := range ascending {
+		ascending[i] = i
+	}
+	descending := slices.Clone(ascending)
+	slices.Reverse(descending)
+
+	tests := []struct {
+		name string
+		in   []int
+	}{
+		{"empty", nil},
+		{"single", []int{42}},
+		{"sorted", ascending},
+		{"reverse sorted", descending},
+		{"all equal", make([]int, 1000)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := sortedCopy(tt.in)
+
+			if got := MergeSort(tt.in); !slices.Equal(got, want) {
+				t.Fatalf("MergeSort = %v", got)
+			}
+
+			q := slices.Clone(tt.in)
+			QuickSort(q)
+			if !slices.Equal(q, want) {
+				t.Fatalf("QuickSort = %v", q)
+			}
+		})
+	}
+}
+
+func TestMergeSortFuncStable(t *testing.T) {
+	type rec struct{ key, seq int }
+
+	rng := rand.New(rand.NewSource(2))
+	in := make([]rec, 500)
+	for i := range in {
+		in[i] = rec{key: rng.Intn(10), seq: i}
+	}
+
+	want := slices.Clone(in)
+	sort.SliceStable(want, func(i, j int) bool { return want[i].key < want[j].key })
+
+	got := MergeSortFunc(in, func(a, b rec) bool { return a.key < b.key })
+	if !slices.Equal(got, want) {
+		t.Fatal("MergeSortFunc did not preserve the order of equal elements")
+	}
+}
+
+func TestQuickSortFuncDescending(t *testing.T) {
+	s := []string{"pear", "apple", "fig", "kiwi"}
+	QuickSortFunc(s, func(a, b string) bool { return a > b })
+
+	if want := []string{"pear", "kiwi", "fig", "apple"}; !slices.Equal(s, want) {
+		t.Fatalf("QuickSortFunc = %v, want %v", s, want)
+	}
+}
@@ -0,0 +1,80 @@
+package algo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrCycle is wrapped by the error TopoSort returns when the graph contains
+// a cycle.
+var ErrCycle = errors.New("algo: dependency cycle")
+
+// TopoSort orders nodes so that every node comes after all of its
+// dependencies, where edges[n] lists the nodes n depends on. Nodes that
+// appear only in edges are included too. The result is deterministic:
+// nodes are visited in the order given, then any remaining keys of edges in
+// sorted order, and dependencies in the order listed.
+//
+// If the graph has a cycle, TopoSort returns an error wrapping ErrCycle
+// that names the nodes on the cycle.
+func TopoSort(nodes []string, edges map[string][]string) ([]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(nodes))
+	order := make([]string, 0, len(nodes))
+	var path []string
+
+	var visit func(n string) error
+	visit = func(n string) error {
+		switch state[n] {
+		case visited:
+			return nil
+		case visiting:
+			start := 0
+			for i, p := range path {
+				if p == n {
+					start = i
+					break
+				}
+			}
+			cycle := append(path[start:len(path):len(path)], n)
+			return fmt.Errorf("%w: %s", ErrCycle, strings.Join(cycle, " -> "))
+		}
+
+		state[n] = visiting
+		path = append(path, n)
+		for _, dep := range edges[n] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[n] = visited
+		order = append(order, n)
+		return nil
+	}
+
+	for _, n := range nodes {
+		if err := visit(n); err != nil {
+			return nil, err
+		}
+	}
+	rest := make([]string, 0, len(edges))
+	for n := range edges {
+		rest = append(rest, n)
+	}
+	slices.Sort(rest)
+	for _, n := range rest {
+		if err := visit(n); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
@@ -0,0 +1,74 @@
+package algo
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// checkOrder fails if any node in order precedes one of its dependencies.
+func checkOrder(t *testing.T, order []string, edges map[string][]string) {
+	t.Helper()
+	pos := make(map[string]int, len(order))
+	for i, n := range order {
+		pos[n] = i
+	}
+	for n, deps := range edges {
+		for _, d := range deps {
+			if pos[d] >= pos[n] {
+				t.Errorf("%s at %d does not follow dependency %s at %d", n, pos[n], d, pos[d])
+			}
+		}
+	}
+}
+
+func TestTopoSortDAG(t *testing.T) {
+	edges := map[string][]string{
+		"app":  {"db", "log"},
+		"db":   {"conf", "log"},
+		"log":  {"conf"},
+		"conf": nil,
+	}
+	order, err := TopoSort([]string{"app"}, edges)
+	if err != nil {
+		t.Fatalf("TopoSort: %v", err)
+	}
+	if want := []string{"conf", "log", "db", "app"}; !slices.Equal(order, want) {
+		t.Fatalf("TopoSort = %v, want %v", order, want)
+	}
+	checkOrder(t, order, edges)
+}
+
+func TestTopoSortCycle(t *testing.T) {
+	edges := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"b"},
+	}
+	_, err := TopoSort([]string{"a"}, edges)
+	if !errors.Is(err, ErrCycle) {
+		t.Fatalf("TopoSort error = %v, want ErrCycle", err)
+	}
+	if got, want := err.Error(), "algo: dependency cycle: b -> c -> b"; got != want {
+		t.Fatalf("error = %q, want %q", got, want)
+	}
+	if strings.Contains(err.Error(), "a ->") {
+		t.Fatalf("error %q names a node outside the cycle", err)
+	}
+}
+
+func TestTopoSortDisconnected(t *testing.T) {
+	edges := map[string][]string{
+		"y": {"x"},
+		"b": {"a"},
+	}
+	order, err := TopoSort([]string{"z"}, edges)
+	if err != nil {
+		t.Fatalf("TopoSort: %v", err)
+	}
+	if want := []string{"z", "a", "b", "x", "y"}; !slices.Equal(order, want) {
+		t.Fatalf("TopoSort = %v, want %v", order, want)
+	}
+	checkOrder(t, order, edges)
+}
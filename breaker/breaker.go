@@ -0,0 +1,164 @@
+// Package breaker implements the circuit breaker pattern.
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by Execute while the breaker is open.
+var ErrCircuitOpen = errors.New("breaker: circuit open")
+
+// State is the state of a CircuitBreaker.
+type State int
+
+const (
+	// Closed lets every call through and counts consecutive failures.
+	Closed State = iota
+	// Open rejects every call until the cooldown has elapsed.
+	Open
+	// HalfOpen lets a single trial call through to probe for recovery.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker stops calling a failing operation for a while instead of
+// letting every caller wait on it. After threshold consecutive failures it
+// opens and rejects calls with ErrCircuitOpen. Once cooldown has elapsed it
+// becomes half-open and lets one trial call through: success closes it
+// again, failure reopens it for another cooldown. It is safe for concurrent
+// use.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    State
+	gen      uint64 // incremented on every state change
+	failures int
+	openedAt time.Time
+	trial    bool // a half-open trial call is in flight
+}
+
+// NewCircuitBreaker returns a closed breaker that opens after threshold
+// consecutive failures and stays open for cooldown. A non-positive
+// threshold is treated as one.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+// Execute calls fn unless the breaker is open, and records the outcome.
+// While open, or while a half-open trial is already running, it returns
+// ErrCircuitOpen without calling fn. Otherwise it returns fn's error.
+//
+// An outcome only counts towards the state the call was admitted under: a
+// slow call that finishes after the breaker has changed state is ignored.
+// A panic in fn is recorded as a failure and then propagates to the caller.
+func (b *CircuitBreaker) Execute(fn func() error) error {
+	gen, err := b.before()
+	if err != nil {
+		return err
+	}
+
+	ok := false
+	defer func() { b.after(gen, ok) }()
+	err = fn()
+	ok = err == nil
+	return err
+}
+
+// State returns the current state, accounting for an elapsed cooldown.
+func (b *CircuitBreaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(time.Now())
+	return b.state
+}
+
+// before admits or rejects a call, returning the generation it was admitted
+// under.
+func (b *CircuitBreaker) before() (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(time.Now())
+	switch b.state {
+	case Open:
+		return 0, ErrCircuitOpen
+	case HalfOpen:
+		if b.trial {
+			return 0, ErrCircuitOpen
+		}
+		b.trial = true
+	}
+	return b.gen, nil
+}
+
+// after records the outcome of a call admitted under generation gen.
+// Outcomes from an earlier generation are stale and dropped.
+func (b *CircuitBreaker) after(gen uint64, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if gen != b.gen {
+		return
+	}
+
+	if b.state == HalfOpen {
+		b.trial = false
+		if ok {
+			b.setState(Closed)
+		} else {
+			b.trip(time.Now())
+		}
+		return
+	}
+
+	if ok {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.trip(time.Now())
+	}
+}
+
+// advance moves an open breaker to half-open once its cooldown is over. It
+// must be called with b.mu held.
+func (b *CircuitBreaker) advance(now time.Time) {
+	if b.state == Open && now.Sub(b.openedAt) >= b.cooldown {
+		b.setState(HalfOpen)
+	}
+}
+
+// trip opens the breaker. It must be called with b.mu held.
+func (b *CircuitBreaker) trip(now time.Time) {
+	b.setState(Open)
+	b.openedAt = now
+}
+
+// setState moves to state s and starts a new generation. It must be called
+// with b.mu held.
+func (b *CircuitBreaker) setState(s State) {
+	b.state = s
+	b.gen++
+	b.failures = 0
+}
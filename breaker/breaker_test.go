@@ -0,0 +1,189 @@
+package breaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var errFail = errors.New("fail")
+
+func fail() error    { return errFail }
+func succeed() error { return nil }
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	const cooldown = 20 * time.Millisecond
+	b := NewCircuitBreaker(3, cooldown)
+
+	for i := 0; i < 2; i++ {
+		if err := b.Execute(fail); !errors.Is(err, errFail) {
+			t.Fatalf("Execute = %v, want %v", err, errFail)
+		}
+	}
+	if s := b.State(); s != Closed {
+		t.Fatalf("state after 2 failures = %v, want closed", s)
+	}
+
+	b.Execute(fail)
+	if s := b.State(); s != Open {
+		t.Fatalf("state after 3 failures = %v, want open", s)
+	}
+
+	called := false
+	if err := b.Execute(func() error { called = true; return nil }); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Execute while open = %v, want ErrCircuitOpen", err)
+	}
+	if called {
+		t.Fatal("fn called while open")
+	}
+
+	time.Sleep(cooldown + 5*time.Millisecond)
+	if s := b.State(); s != HalfOpen {
+		t.Fatalf("state after cooldown = %v, want half-open", s)
+	}
+
+	// A failed trial reopens the breaker for another cooldown.
+	b.Execute(fail)
+	if s := b.State(); s != Open {
+		t.Fatalf("state after failed trial = %v, want open", s)
+	}
+
+	time.Sleep(cooldown + 5*time.Millisecond)
+	if err := b.Execute(succeed); err != nil {
+		t.Fatalf("trial Execute = %v, want nil", err)
+	}
+	if s := b.State(); s != Closed {
+		t.Fatalf("state after successful trial = %v, want closed", s)
+	}
+}
+
+func TestCircuitBreakerSuccessResetsFailures(t *testing.T) {
+	b := NewCircuitBreaker(2, time.Hour)
+	b.Execute(fail)
+	b.Execute(succeed)
+	b.Execute(fail)
+
+	if s := b.State(); s != Closed {
+		t.Fatalf("state = %v, want closed: failures must be consecutive", s)
+	}
+}
+
+func TestCircuitBreakerIgnoresStaleOutcomes(t *testing.T) {
+	const cooldown = 20 * time.Millisecond
+	b := NewCircuitBreaker(1, cooldown)
+
+	// A slow call admitted while closed.
+	slowRelease := make(chan struct{})
+	slowDone := make(chan error)
+	slowStarted := make(chan struct{})
+	go func() {
+		slowDone <- b.Execute(func() error {
+			close(slowStarted)
+			<-slowRelease
+			return nil
+		})
+	}()
+	<-slowStarted
+
+	b.Execute(fail)
+	if s := b.State(); s != Open {
+		t.Fatalf("state = %v, want open", s)
+	}
+	time.Sleep(cooldown + 5*time.Millisecond)
+
+	// The half-open trial is now in flight.
+	trialRelease := make(chan struct{})
+	trialDone := make(chan error)
+	trialStarted := make(chan struct{})
+	go func() {
+		trialDone <- b.Execute(func() error {
+			close(trialStarted)
+			<-trialRelease
+			return nil
+		})
+	}()
+	<-trialStarted
+
+	// The slow closed-state call succeeds mid-trial. It must neither close
+	// the breaker nor free the trial slot.
+	close(slowRelease)
+	<-slowDone
+	if s := b.State(); s != HalfOpen {
+		t.Fatalf("state after stale success = %v, want half-open", s)
+	}
+	if err := b.Execute(succeed); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("second call during trial = %v, want ErrCircuitOpen", err)
+	}
+
+	close(trialRelease)
+	if err := <-trialDone; err != nil {
+		t.Fatalf("trial = %v, want nil", err)
+	}
+	if s := b.State(); s != Closed {
+		t.Fatalf("state after trial = %v, want closed", s)
+	}
+}
+
+func TestCircuitBreakerLateFailureDoesNotExtendCooldown(t *testing.T) {
+	const cooldown = 30 * time.Millisecond
+	b := NewCircuitBreaker(1, cooldown)
+
+	release := make(chan struct{})
+	done := make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		b.Execute(func() error {
+			close(started)
+			<-release
+			return errFail
+		})
+		close(done)
+	}()
+	<-started
+
+	b.Execute(fail)
+	time.Sleep(cooldown / 2)
+	close(release) // late failure lands while open
+	<-done
+
+	time.Sleep(cooldown/2 + 5*time.Millisecond)
+	if s := b.State(); s != HalfOpen {
+		t.Fatalf("state = %v, want half-open: late failure extended the cooldown", s)
+	}
+}
+
+// executePanic runs a panicking fn through b and recovers the panic.
+func executePanic(b *CircuitBreaker) (recovered any) {
+	defer func() { recovered = recover() }()
+	b.Execute(func() error { panic("boom") })
+	return nil
+}
+
+func TestCircuitBreakerPanicCountsAsFailure(t *testing.T) {
+	const cooldown = 20 * time.Millisecond
+	b := NewCircuitBreaker(1, cooldown)
+
+	if r := executePanic(b); r != "boom" {
+		t.Fatalf("recovered %v, want boom", r)
+	}
+	if s := b.State(); s != Open {
+		t.Fatalf("state after panic while closed = %v, want open", s)
+	}
+
+	// A panicking trial reopens the breaker instead of wedging it.
+	time.Sleep(cooldown + 5*time.Millisecond)
+	if r := executePanic(b); r != "boom" {
+		t.Fatalf("recovered %v from trial, want boom", r)
+	}
+	if s := b.State(); s != Open {
+		t.Fatalf("state after panicking trial = %v, want open", s)
+	}
+
+	time.Sleep(cooldown + 5*time.Millisecond)
+	if err := b.Execute(succeed); err != nil {
+		t.Fatalf("Execute after next cooldown = %v, want nil", err)
+	}
+	if s := b.State(); s != Closed {
+		t.Fatalf("state after successful trial = %v, want closed", s)
+	}
+}
@@ -0,0 +1,80 @@
+// Package cache provides in-memory caches.
+package cache
+
+import "container/list"
+
+// LRU is a fixed-capacity cache that evicts the least recently used entry
+// when full. Get and Put run in O(1). An LRU is not safe for concurrent use.
+type LRU[K comparable, V any] struct {
+	// OnEvict, if non-nil, is called with each entry evicted to make room
+	// for a new one.
+	OnEvict func(K, V)
+
+	capacity int
+	ll       *list.List
+	items    map[K]*list.Element
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewLRU returns an empty cache holding at most capacity entries. A
+// non-positive capacity is treated as one.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &LRU[K, V]{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[K]*list.Element, capacity),
+	}
+}
+
+// Get returns the value stored for k and marks it as most recently used.
+func (c *LRU[K, V]) Get(k K) (V, bool) {
+	el, ok := c.items[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	c.ll.MoveToFront(el)
+	return el.Value.(*entry[K, V]).value, true
+}
+
+// Put stores v under k, marking it as most recently used. If the cache is
+// over capacity afterwards, the least recently used entry is evicted.
+func (c *LRU[K, V]) Put(k K, v V) {
+	if el, ok := c.items[k]; ok {
+		el.Value.(*entry[K, V]).value = v
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[k] = c.ll.PushFront(&entry[K, V]{key: k, value: v})
+	if c.ll.Len() > c.capacity {
+		c.evictOldest()
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRU[K, V]) Len() int {
+	return c.ll.Len()
+}
+
+func (c *LRU[K, V]) evictOldest() {
+	el := c.ll.Back()
+	if el == nil {
+		return
+	}
+
+	e := c.ll.Remove(el).(*entry[K, V])
+	delete(c.items, e.key)
+	if c.OnEvict != nil {
+		c.OnEvict(e.key, e.value)
+	}
+}
@@ -0,0 +1,59 @@
+package cache
+
+import "testing"
+
+func TestLRUGetPromotes(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("Get(a) = %d, %v; want 1, true", v, ok)
+	}
+
+	// "a" was just used, so "b" is now the least recently used entry.
+	c.Put("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("b should have been evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("a should have survived eviction")
+	}
+}
+
+func TestLRUEvictCallback(t *testing.T) {
+	c := NewLRU[string, int](2)
+
+	var evicted []string
+	c.OnEvict = func(k string, v int) {
+		evicted = append(evicted, k)
+		if k == "a" && v != 10 {
+			t.Errorf("OnEvict(a) value = %d, want 10", v)
+		}
+	}
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("a", 10) // update moves a to the front without evicting
+	if len(evicted) != 0 {
+		t.Fatalf("update evicted %v", evicted)
+	}
+
+	c.Put("c", 3)
+	c.Put("d", 4)
+
+	if len(evicted) != 2 || evicted[0] != "b" || evicted[1] != "a" {
+		t.Fatalf("evicted = %v, want [b a]", evicted)
+	}
+	if c.Len() != 2 {
+		t.Fatalf("Len = %d, want 2", c.Len())
+	}
+}
+
+func TestLRUGetMissing(t *testing.T) {
+	c := NewLRU[int, string](1)
+	if v, ok := c.Get(1); ok || v != "" {
+		t.Fatalf("Get on empty cache = %q, %v", v, ok)
+	}
+}
@@ -0,0 +1,55 @@
+package chanutil
+
+import "time"
+
+// Debounce forwards the most recent value received from in once d has
+// elapsed without a newer value arriving, collapsing bursts into a single
+// emission. When in is closed, any pending value is flushed before the
+// returned channel is closed.
+func Debounce[T any](in <-chan T, d time.Duration) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		var (
+			pending T
+			has     bool
+			timer   = time.NewTimer(d)
+		)
+		timer.Stop()
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if has {
+						out <- pending
+					}
+					return
+				}
+				pending, has = v, true
+				resetTimer(timer, d)
+			case <-timer.C:
+				if has {
+					out <- pending
+					has = false
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// resetTimer stops t, discarding any expiry that has not been received yet,
+// and rearms it to fire after d.
+func resetTimer(t *time.Timer, d time.Duration) {
+	if !t.Stop() {
+		select {
+		case <-t.C:
+		default:
+		}
+	}
+	t.Reset(d)
+}
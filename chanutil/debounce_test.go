@@ -0,0 +1,50 @@
+package chanutil
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDebounceBursts(t *testing.T) {
+	in := make(chan int)
+	out := Debounce(in, 30*time.Millisecond)
+
+	go func() {
+		defer close(in)
+		for _, burst := range [][]int{{1, 2, 3}, {10, 20}} {
+			for _, v := range burst {
+				in <- v
+				time.Sleep(2 * time.Millisecond)
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}()
+
+	var got []int
+	for v := range out {
+		got = append(got, v)
+	}
+	if want := []int{3, 20}; !slices.Equal(got, want) {
+		t.Fatalf("Debounce = %v, want %v", got, want)
+	}
+}
+
+func TestDebounceFlushesOnClose(t *testing.T) {
+	in := make(chan string)
+	out := Debounce(in, time.Hour)
+
+	go func() {
+		in <- "a"
+		in <- "b"
+		close(in)
+	}()
+
+	var got []string
+	for v := range out {
+		got = append(got, v)
+	}
+	if want := []string{"b"}; !slices.Equal(got, want) {
+		t.Fatalf("Debounce = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package chanutil
+
+// Dedup forwards each value received from in the first time it is seen and
+// drops later repeats. Every distinct value is remembered for the lifetime
+// of the stream, so memory grows with the number of distinct values. The
+// returned channel is closed once in is closed.
+func Dedup[T comparable](in <-chan T) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		seen := make(map[T]struct{})
+		for v := range in {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			out <- v
+		}
+	}()
+
+	return out
+}
+
+// DedupConsecutive forwards values received from in, dropping any value
+// equal to the one immediately before it. Only the previous value is kept,
+// so non-adjacent repeats pass through. The returned channel is closed once
+// in is closed.
+func DedupConsecutive[T comparable](in <-chan T) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		var (
+			prev T
+			has  bool
+		)
+		for v := range in {
+			if has && v == prev {
+				continue
+			}
+			prev, has = v, true
+			out <- v
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,54 @@
+package chanutil
+
+import (
+	"slices"
+	"testing"
+)
+
+func feed[T any](vals ...T) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for _, v := range vals {
+			ch <- v
+		}
+	}()
+	return ch
+}
+
+func collect[T any](ch <-chan T) []T {
+	var out []T
+	for v := range ch {
+		out = append(out, v)
+	}
+	return out
+}
+
+func TestDedup(t *testing.T) {
+	// Scattered (1, 2, 0) and consecutive (1 1, 3 3 3) duplicates.
+	in := []int{0, 1, 1, 2, 1, 3, 3, 3, 2, 0, 4}
+
+	tests := []struct {
+		name string
+		fn   func(<-chan int) <-chan int
+		want []int
+	}{
+		{"Dedup", Dedup[int], []int{0, 1, 2, 3, 4}},
+		{"DedupConsecutive", DedupConsecutive[int], []int{0, 1, 2, 1, 3, 2, 0, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := collect(tt.fn(feed(in...))); !slices.Equal(got, tt.want) {
+				t.Fatalf("%s = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedupConsecutiveZeroValueFirst(t *testing.T) {
+	// The zero value must not be mistaken for a previous value.
+	got := collect(DedupConsecutive(feed("", "", "a")))
+	if want := []string{"", "a"}; !slices.Equal(got, want) {
+		t.Fatalf("DedupConsecutive = %q, want %q", got, want)
+	}
+}
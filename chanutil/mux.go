@@ -0,0 +1,103 @@
+package chanutil
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrMuxClosed is returned by Client.Call once its Mux has been closed.
+var ErrMuxClosed = errors.New("chanutil: mux closed")
+
+// Request is a message to a Mux server carrying the channel on which the
+// server sends its reply.
+type Request[Req any, Resp any] struct {
+	Payload Req
+	Reply   chan Resp
+}
+
+// Mux serves requests on a single goroutine, in the style of an actor.
+// Because the handler only ever runs on that goroutine, it may own mutable
+// state without further locking.
+type Mux[Req any, Resp any] struct {
+	requests  chan Request[Req, Resp]
+	quit      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewMux starts a server goroutine that calls handle for each request in
+// turn and sends the result on the request's reply channel. Call Close to
+// stop it.
+func NewMux[Req any, Resp any](handle func(Req) Resp) *Mux[Req, Resp] {
+	m := &Mux[Req, Resp]{
+		requests: make(chan Request[Req, Resp]),
+		quit:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	go func() {
+		defer close(m.done)
+		for {
+			select {
+			case req := <-m.requests:
+				// Reply channels from Client are buffered, so this never
+				// blocks on a caller that has given up.
+				req.Reply <- handle(req.Payload)
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+
+	return m
+}
+
+// Requests returns the channel the server receives on, for callers that
+// build their own Request values. Their reply channel must have room for
+// one value or the server blocks until it is received.
+func (m *Mux[Req, Resp]) Requests() chan<- Request[Req, Resp] {
+	return m.requests
+}
+
+// Client returns a client for sending requests to m.
+func (m *Mux[Req, Resp]) Client() *Client[Req, Resp] {
+	return &Client[Req, Resp]{mux: m}
+}
+
+// Close stops the server after any request in progress and waits for it to
+// exit. It is safe to call Close more than once.
+func (m *Mux[Req, Resp]) Close() {
+	m.closeOnce.Do(func() {
+		close(m.quit)
+	})
+	<-m.done
+}
+
+// Client sends requests to a Mux. It is safe for concurrent use.
+type Client[Req any, Resp any] struct {
+	mux *Mux[Req, Resp]
+}
+
+// Call sends req to the server with a fresh reply channel and waits for the
+// response. It returns ctx.Err() if ctx is done first, or ErrMuxClosed if
+// the Mux is closed before the request is accepted.
+func (c *Client[Req, Resp]) Call(ctx context.Context, req Req) (Resp, error) {
+	var zero Resp
+	reply := make(chan Resp, 1)
+
+	select {
+	case c.mux.requests <- Request[Req, Resp]{Payload: req, Reply: reply}:
+	case <-c.mux.quit:
+		return zero, ErrMuxClosed
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+
+	select {
+	case resp := <-reply:
+		return resp, nil
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
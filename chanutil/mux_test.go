@@ -0,0 +1,95 @@
+package chanutil
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// newCounter returns a Mux acting as a counter. Its state is owned by the
+// server goroutine, so the race detector flags any access from elsewhere.
+func newCounter() *Mux[string, int] {
+	count := 0
+	return NewMux(func(op string) int {
+		if op == "inc" {
+			count++
+		}
+		return count
+	})
+}
+
+func TestMuxCounter(t *testing.T) {
+	m := newCounter()
+	defer m.Close()
+	ctx := context.Background()
+
+	const workers, incs = 8, 100
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			c := m.Client()
+			for range incs {
+				if _, err := c.Call(ctx, "inc"); err != nil {
+					t.Errorf("Call(inc): %v", err)
+					return
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			c := m.Client()
+			last := 0
+			for range incs {
+				n, err := c.Call(ctx, "get")
+				if err != nil {
+					t.Errorf("Call(get): %v", err)
+					return
+				}
+				if n < last {
+					t.Errorf("get = %d after %d, want non-decreasing", n, last)
+				}
+				last = n
+			}
+		}()
+	}
+	wg.Wait()
+
+	n, err := m.Client().Call(ctx, "get")
+	if err != nil {
+		t.Fatalf("Call(get): %v", err)
+	}
+	if n != workers*incs {
+		t.Fatalf("count = %d, want %d", n, workers*incs)
+	}
+}
+
+func TestMuxClosed(t *testing.T) {
+	m := newCounter()
+	m.Close()
+	m.Close() // idempotent
+
+	if _, err := m.Client().Call(context.Background(), "inc"); !errors.Is(err, ErrMuxClosed) {
+		t.Fatalf("Call after Close = %v, want ErrMuxClosed", err)
+	}
+}
+
+func TestMuxContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	m := NewMux(func(int) int { <-release; return 0 })
+	defer m.Close()
+	defer close(release)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := m.Client().Call(ctx, 1)
+		errc <- err
+	}()
+	cancel()
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Call = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,31 @@
+// Package chanutil provides generic helpers for working with channels.
+package chanutil
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrChannelClosed is returned when receiving from a channel that has been
+// closed.
+var ErrChannelClosed = errors.New("chanutil: channel closed")
+
+// ReceiveWithContext receives a single value from ch. It returns the zero
+// value and ctx.Err() if the context is cancelled or its deadline expires
+// first, and the zero value and ErrChannelClosed if ch is closed.
+//
+// Unlike selecting on time.After, no timer outlives the call: the deadline
+// is owned by ctx and released by its cancel function.
+func ReceiveWithContext[T any](ctx context.Context, ch <-chan T) (T, error) {
+	var zero T
+
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			return zero, ErrChannelClosed
+		}
+		return v, nil
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,48 @@
+package chanutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReceiveWithContext(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 42
+
+	v, err := ReceiveWithContext(context.Background(), ch)
+	if err != nil || v != 42 {
+		t.Fatalf("ReceiveWithContext = %d, %v; want 42, nil", v, err)
+	}
+}
+
+func TestReceiveWithContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	v, err := ReceiveWithContext(ctx, make(chan int))
+	if !errors.Is(err, context.Canceled) || v != 0 {
+		t.Fatalf("ReceiveWithContext = %d, %v; want 0, %v", v, err, context.Canceled)
+	}
+}
+
+func TestReceiveWithContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	v, err := ReceiveWithContext(ctx, make(chan string))
+	if !errors.Is(err, context.DeadlineExceeded) || v != "" {
+		t.Fatalf("ReceiveWithContext = %q, %v; want \"\", %v", v, err, context.DeadlineExceeded)
+	}
+}
+
+func TestReceiveWithContextClosed(t *testing.T) {
+	ch := make(chan int)
+	close(ch)
+
+	v, err := ReceiveWithContext(context.Background(), ch)
+	if !errors.Is(err, ErrChannelClosed) || v != 0 {
+		t.Fatalf("ReceiveWithContext = %d, %v; want 0, %v", v, err, ErrChannelClosed)
+	}
+}
@@ -0,0 +1,65 @@
+package collections
+
+import (
+	"container/list"
+	"encoding/json"
+)
+
+// omPair is the JSON form of a single OrderedMap entry.
+type omPair[K comparable, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+// MarshalJSON encodes the map as an array of {"key": k, "value": v} objects
+// in insertion order. A JSON object is not used because its key order is
+// not preserved by decoders. It has a value receiver so that maps stored by
+// value, such as struct fields, encode the same way as pointers to them.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	pairs := make([]omPair[K, V], 0, m.Len())
+	if m.ll != nil {
+		for el := m.ll.Front(); el != nil; el = el.Next() {
+			e := el.Value.(*omEntry[K, V])
+			pairs = append(pairs, omPair[K, V]{Key: e.key, Value: e.value})
+		}
+	}
+	return json.Marshal(pairs)
+}
+
+// UnmarshalJSON replaces the contents of the map with the pairs encoded by
+// MarshalJSON, restoring their order. If a key appears more than once, the
+// last value wins and the key keeps its first position.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	var pairs []omPair[K, V]
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return err
+	}
+
+	m.ll = list.New()
+	m.items = make(map[K]*list.Element, len(pairs))
+	for _, p := range pairs {
+		m.Set(p.Key, p.Value)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array in unspecified order. Like
+// OrderedMap's, it has a value receiver so sets stored by value encode too.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON replaces the contents of the set with the elements of a JSON
+// array. Duplicate elements are collapsed.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	s.items = make(map[T]struct{}, len(items))
+	for _, v := range items {
+		s.items[v] = struct{}{}
+	}
+	return nil
+}
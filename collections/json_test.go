@@ -0,0 +1,97 @@
+package collections
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestOrderedMapJSONRoundTrip(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("zebra", 1)
+	m.Set("apple", 2)
+	m.Set("mango", 3)
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want := `[{"key":"zebra","value":1},{"key":"apple","value":2},{"key":"mango","value":3}]`; string(data) != want {
+		t.Fatalf("Marshal = %s, want %s", data, want)
+	}
+
+	got := NewOrderedMap[string, int]()
+	got.Set("stale", 0)
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if keys, want := got.Keys(), []string{"zebra", "apple", "mango"}; !slices.Equal(keys, want) {
+		t.Fatalf("Keys after round trip = %v, want %v", keys, want)
+	}
+	if v, _ := got.Get("apple"); v != 2 {
+		t.Fatalf("Get(apple) = %d, want 2", v)
+	}
+}
+
+func TestOrderedMapJSONByValue(t *testing.T) {
+	type doc struct {
+		Fields OrderedMap[string, string] `json:"fields"`
+		Tags   Set[string]                `json:"tags"`
+	}
+
+	d := doc{Fields: *NewOrderedMap[string, string](), Tags: *NewSet("x")}
+	d.Fields.Set("b", "2")
+	d.Fields.Set("a", "1")
+
+	// Marshaling d by value must still use the custom encoders.
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want := `{"fields":[{"key":"b","value":"2"},{"key":"a","value":"1"}],"tags":["x"]}`; string(data) != want {
+		t.Fatalf("Marshal = %s, want %s", data, want)
+	}
+
+	var back doc
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if keys, want := back.Fields.Keys(), []string{"b", "a"}; !slices.Equal(keys, want) {
+		t.Fatalf("Keys after round trip = %v, want %v", keys, want)
+	}
+	if !back.Tags.Contains("x") || back.Tags.Len() != 1 {
+		t.Fatalf("Tags after round trip = %v, want [x]", back.Tags.Slice())
+	}
+}
+
+func TestOrderedMapJSONZeroValue(t *testing.T) {
+	var m OrderedMap[string, int]
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != "[]" {
+		t.Fatalf("Marshal = %s, want []", data)
+	}
+}
+
+func TestSetJSONRoundTrip(t *testing.T) {
+	data, err := json.Marshal(NewSet(3, 1, 2))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var s Set[int]
+	if err := json.Unmarshal([]byte(`[1, 2, 2, 3]`), &s); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	var back Set[int]
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	for _, got := range []*Set[int]{&s, &back} {
+		if elems := sorted(got.Slice()); !slices.Equal(elems, []int{1, 2, 3}) {
+			t.Fatalf("elements = %v, want [1 2 3]", elems)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package collections
+
+import "container/list"
+
+type omEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// OrderedMap is a map that remembers the order in which keys were first
+// inserted. Updating an existing key keeps its position; deleting a key and
+// setting it again moves it to the end. Set, Get and Delete run in O(1).
+// An OrderedMap is not safe for concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	ll    *list.List
+	items map[K]*list.Element
+}
+
+// NewOrderedMap returns an empty map.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		ll:    list.New(),
+		items: make(map[K]*list.Element),
+	}
+}
+
+// Set stores v under k. A new key is appended to the end of the order; an
+// existing key has its value replaced in place.
+func (m *OrderedMap[K, V]) Set(k K, v V) {
+	if el, ok := m.items[k]; ok {
+		el.Value.(*omEntry[K, V]).value = v
+		return
+	}
+	m.items[k] = m.ll.PushBack(&omEntry[K, V]{key: k, value: v})
+}
+
+// Get returns the value stored under k and whether it was present.
+func (m *OrderedMap[K, V]) Get(k K) (V, bool) {
+	el, ok := m.items[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return el.Value.(*omEntry[K, V]).value, true
+}
+
+// Delete removes k. Deleting a missing key is a no-op.
+func (m *OrderedMap[K, V]) Delete(k K) {
+	if el, ok := m.items[k]; ok {
+		m.ll.Remove(el)
+		delete(m.items, k)
+	}
+}
+
+// Len returns the number of entries in the map.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.items)
+}
+
+// Keys returns the keys in insertion order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.items))
+	for el := m.ll.Front(); el != nil; el = el.Next() {
+		keys = append(keys, el.Value.(*omEntry[K, V]).key)
+	}
+	return keys
+}
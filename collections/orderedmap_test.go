@@ -0,0 +1,47 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOrderedMapOrder(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	m.Set("a", 10) // re-set keeps position
+	if got, want := m.Keys(), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("Keys after re-set = %v, want %v", got, want)
+	}
+	if v, _ := m.Get("a"); v != 10 {
+		t.Fatalf("Get(a) = %d, want 10", v)
+	}
+
+	m.Delete("a")
+	m.Set("a", 100) // delete then re-insert moves to the end
+	if got, want := m.Keys(), []string{"b", "c", "a"}; !slices.Equal(got, want) {
+		t.Fatalf("Keys after delete and re-insert = %v, want %v", got, want)
+	}
+
+	m.Delete("c")
+	m.Delete("missing")
+	m.Set("d", 4)
+	if got, want := m.Keys(), []string{"b", "a", "d"}; !slices.Equal(got, want) {
+		t.Fatalf("Keys = %v, want %v", got, want)
+	}
+	if m.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", m.Len())
+	}
+}
+
+func TestOrderedMapGetMissing(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	if v, ok := m.Get(1); ok || v != "" {
+		t.Fatalf("Get on empty map = %q, %v", v, ok)
+	}
+	if keys := m.Keys(); len(keys) != 0 {
+		t.Fatalf("Keys on empty map = %v", keys)
+	}
+}
@@ -0,0 +1,74 @@
+package collections
+
+import "container/heap"
+
+// PriorityQueue is a binary heap ordered by a caller-supplied comparator:
+// Pop always returns the element for which less reports true against every
+// other element. Elements that compare equal are popped in insertion order.
+type PriorityQueue[T any] struct {
+	h pqHeap[T]
+}
+
+// NewPriorityQueue returns an empty queue ordered by less. Passing
+// func(a, b int) bool { return a < b } yields a min-heap.
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{h: pqHeap[T]{less: less}}
+}
+
+// Push adds v to the queue.
+func (pq *PriorityQueue[T]) Push(v T) {
+	heap.Push(&pq.h, pqItem[T]{value: v, seq: pq.h.seq})
+	pq.h.seq++
+}
+
+// Pop removes and returns the highest-priority element. It returns the zero
+// value and false if the queue is empty.
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if pq.h.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&pq.h).(pqItem[T]).value, true
+}
+
+// Len returns the number of elements in the queue.
+func (pq *PriorityQueue[T]) Len() int {
+	return pq.h.Len()
+}
+
+type pqItem[T any] struct {
+	value T
+	seq   uint64 // insertion order, used to break ties
+}
+
+// pqHeap implements heap.Interface for PriorityQueue.
+type pqHeap[T any] struct {
+	items []pqItem[T]
+	less  func(a, b T) bool
+	seq   uint64
+}
+
+func (h *pqHeap[T]) Len() int { return len(h.items) }
+
+func (h *pqHeap[T]) Less(i, j int) bool {
+	a, b := h.items[i], h.items[j]
+	if h.less(a.value, b.value) {
+		return true
+	}
+	if h.less(b.value, a.value) {
+		return false
+	}
+	return a.seq < b.seq
+}
+
+func (h *pqHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *pqHeap[T]) Push(x any) { h.items = append(h.items, x.(pqItem[T])) }
+
+func (h *pqHeap[T]) Pop() any {
+	last := len(h.items) - 1
+	it := h.items[last]
+	h.items[last] = pqItem[T]{}
+	h.items = h.items[:last]
+	return it
+}
@@ -0,0 +1,56 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPriorityQueueAscending(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+	for _, v := range []int{5, 1, 9, 3, 7, 2, 8} {
+		pq.Push(v)
+	}
+	if pq.Len() != 7 {
+		t.Fatalf("Len = %d, want 7", pq.Len())
+	}
+
+	var got []int
+	for pq.Len() > 0 {
+		v, ok := pq.Pop()
+		if !ok {
+			t.Fatal("Pop failed on non-empty queue")
+		}
+		got = append(got, v)
+	}
+
+	if want := []int{1, 2, 3, 5, 7, 8, 9}; !slices.Equal(got, want) {
+		t.Fatalf("pop order = %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueueTiesPopInInsertionOrder(t *testing.T) {
+	type task struct {
+		prio int
+		name string
+	}
+	pq := NewPriorityQueue(func(a, b task) bool { return a.prio < b.prio })
+	for _, tk := range []task{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}} {
+		pq.Push(tk)
+	}
+
+	var got []string
+	for pq.Len() > 0 {
+		tk, _ := pq.Pop()
+		got = append(got, tk.name)
+	}
+	if want := []string{"b", "d", "a", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("pop order = %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueuePopEmpty(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b string) bool { return a < b })
+	if v, ok := pq.Pop(); ok || v != "" {
+		t.Fatalf("Pop on empty = %q, %v; want \"\", false", v, ok)
+	}
+}
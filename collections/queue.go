@@ -0,0 +1,63 @@
+package collections
+
+import "sync"
+
+// Queue is a first-in, first-out collection. The zero value is an empty
+// queue ready to use. A Queue is not safe for concurrent use; see
+// ConcurrentQueue.
+type Queue[T any] struct {
+	items []T
+}
+
+// Enqueue adds v to the back of the queue.
+func (q *Queue[T]) Enqueue(v T) {
+	q.items = append(q.items, v)
+}
+
+// Dequeue removes and returns the value at the front of the queue. It
+// returns the zero value and false if the queue is empty.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if len(q.items) == 0 {
+		return zero, false
+	}
+
+	v := q.items[0]
+	q.items[0] = zero
+	q.items = q.items[1:]
+	return v, true
+}
+
+// Len returns the number of values in the queue.
+func (q *Queue[T]) Len() int {
+	return len(q.items)
+}
+
+// ConcurrentQueue is a Queue guarded by a mutex, safe for use by multiple
+// goroutines. The zero value is an empty queue ready to use.
+type ConcurrentQueue[T any] struct {
+	mu sync.Mutex
+	q  Queue[T]
+}
+
+// Enqueue adds v to the back of the queue.
+func (q *ConcurrentQueue[T]) Enqueue(v T) {
+	q.mu.Lock()
+	q.q.Enqueue(v)
+	q.mu.Unlock()
+}
+
+// Dequeue removes and returns the value at the front of the queue. It
+// returns the zero value and false if the queue is empty.
+func (q *ConcurrentQueue[T]) Dequeue() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.q.Dequeue()
+}
+
+// Len returns the number of values in the queue.
+func (q *ConcurrentQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.q.Len()
+}
@@ -0,0 +1,71 @@
+package collections
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	var q Queue[string]
+
+	if v, ok := q.Dequeue(); ok || v != "" {
+		t.Fatalf("Dequeue on empty = %q, %v; want \"\", false", v, ok)
+	}
+
+	q.Enqueue("a")
+	q.Enqueue("b")
+	q.Enqueue("c")
+	if q.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", q.Len())
+	}
+
+	for _, want := range []string{"a", "b", "c"} {
+		if v, ok := q.Dequeue(); !ok || v != want {
+			t.Fatalf("Dequeue = %q, %v; want %q, true", v, ok, want)
+		}
+	}
+	if _, ok := q.Dequeue(); ok {
+		t.Fatal("Dequeue on drained queue succeeded")
+	}
+}
+
+func TestConcurrentQueueProducerConsumer(t *testing.T) {
+	const producers, perProducer = 4, 250
+
+	var q ConcurrentQueue[int]
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Enqueue(p*perProducer + i)
+			}
+		}(p)
+	}
+
+	got := make([]int, 0, producers*perProducer)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for len(got) < producers*perProducer {
+			if v, ok := q.Dequeue(); ok {
+				got = append(got, v)
+			}
+		}
+	}()
+
+	wg.Wait()
+	<-done
+
+	slices.Sort(got)
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("missing or duplicated value near %d", i)
+		}
+	}
+	if q.Len() != 0 {
+		t.Fatalf("Len = %d after draining, want 0", q.Len())
+	}
+}
@@ -0,0 +1,65 @@
+package collections
+
+// RingBuffer is a fixed-capacity circular queue. When full, Push overwrites
+// the oldest element, which makes it suitable for keeping the last N items
+// seen. A RingBuffer is not safe for concurrent use.
+type RingBuffer[T any] struct {
+	items []T
+	head  int // index of the oldest element
+	size  int
+}
+
+// NewRingBuffer returns an empty buffer holding at most capacity elements.
+// It panics if capacity is not positive.
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	if capacity < 1 {
+		panic("collections: ring buffer capacity must be positive")
+	}
+	return &RingBuffer[T]{items: make([]T, capacity)}
+}
+
+// Push appends v as the newest element, overwriting the oldest one if the
+// buffer is full.
+func (r *RingBuffer[T]) Push(v T) {
+	if r.size < len(r.items) {
+		r.items[(r.head+r.size)%len(r.items)] = v
+		r.size++
+		return
+	}
+
+	r.items[r.head] = v
+	r.head = (r.head + 1) % len(r.items)
+}
+
+// Pop removes and returns the oldest element. It returns the zero value and
+// false if the buffer is empty.
+func (r *RingBuffer[T]) Pop() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+
+	v := r.items[r.head]
+	r.items[r.head] = zero
+	r.head = (r.head + 1) % len(r.items)
+	r.size--
+	return v, true
+}
+
+// Len returns the number of elements in the buffer.
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the capacity of the buffer.
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.items)
+}
+
+// Snapshot returns a copy of the buffer's contents ordered oldest to newest.
+func (r *RingBuffer[T]) Snapshot() []T {
+	out := make([]T, r.size)
+	n := copy(out, r.items[r.head:min(r.head+r.size, len(r.items))])
+	copy(out[n:], r.items[:r.size-n])
+	return out
+}
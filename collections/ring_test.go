@@ -0,0 +1,61 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRingBufferOverwrite(t *testing.T) {
+	const capacity = 4
+	r := NewRingBuffer[int](capacity)
+
+	for i := 1; i <= 2*capacity; i++ {
+		r.Push(i)
+	}
+
+	if got, want := r.Snapshot(), []int{5, 6, 7, 8}; !slices.Equal(got, want) {
+		t.Fatalf("Snapshot = %v, want %v", got, want)
+	}
+	if r.Len() != capacity || r.Cap() != capacity {
+		t.Fatalf("Len/Cap = %d/%d, want %d/%d", r.Len(), r.Cap(), capacity, capacity)
+	}
+}
+
+func TestRingBufferWrapAround(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	if got := r.Snapshot(); len(got) != 0 {
+		t.Fatalf("Snapshot of empty buffer = %v", got)
+	}
+
+	r.Push(1)
+	r.Push(2)
+	if v, ok := r.Pop(); !ok || v != 1 {
+		t.Fatalf("Pop = %d, %v; want 1, true", v, ok)
+	}
+	r.Push(3)
+	r.Push(4) // wraps to the start of the backing array
+	if got, want := r.Snapshot(), []int{2, 3, 4}; !slices.Equal(got, want) {
+		t.Fatalf("Snapshot = %v, want %v", got, want)
+	}
+
+	r.Push(5)
+	for _, want := range []int{3, 4, 5} {
+		if v, ok := r.Pop(); !ok || v != want {
+			t.Fatalf("Pop = %d, %v; want %d, true", v, ok, want)
+		}
+	}
+	if _, ok := r.Pop(); ok {
+		t.Fatal("Pop on empty buffer succeeded")
+	}
+}
+
+func TestRingBufferSnapshotIsCopy(t *testing.T) {
+	r := NewRingBuffer[string](2)
+	r.Push("a")
+	snap := r.Snapshot()
+	snap[0] = "changed"
+
+	if got := r.Snapshot(); got[0] != "a" {
+		t.Fatalf("Snapshot shares storage: %v", got)
+	}
+}
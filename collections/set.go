@@ -0,0 +1,89 @@
+// Package collections provides generic container types.
+package collections
+
+// Set is an unordered collection of unique values. The zero value is an
+// empty set ready to use.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet returns a set containing the given items.
+func NewSet[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(items))}
+	for _, v := range items {
+		s.items[v] = struct{}{}
+	}
+	return s
+}
+
+// Add inserts v into the set.
+func (s *Set[T]) Add(v T) {
+	if s.items == nil {
+		s.items = make(map[T]struct{})
+	}
+	s.items[v] = struct{}{}
+}
+
+// Remove deletes v from the set. Removing a missing value is a no-op.
+func (s *Set[T]) Remove(v T) {
+	delete(s.items, v)
+}
+
+// Contains reports whether v is in the set.
+func (s *Set[T]) Contains(v T) bool {
+	_, ok := s.items[v]
+	return ok
+}
+
+// Len returns the number of values in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Slice returns the values of the set in unspecified order.
+func (s *Set[T]) Slice() []T {
+	out := make([]T, 0, len(s.items))
+	for v := range s.items {
+		out = append(out, v)
+	}
+	return out
+}
+
+// Union returns a new set holding every value in s or other.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	out := &Set[T]{items: make(map[T]struct{}, s.Len()+other.Len())}
+	for v := range s.items {
+		out.items[v] = struct{}{}
+	}
+	for v := range other.items {
+		out.items[v] = struct{}{}
+	}
+	return out
+}
+
+// Intersect returns a new set holding the values present in both s and other.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+
+	out := &Set[T]{items: make(map[T]struct{})}
+	for v := range small.items {
+		if large.Contains(v) {
+			out.items[v] = struct{}{}
+		}
+	}
+	return out
+}
+
+// Difference returns a new set holding the values in s that are not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	out := &Set[T]{items: make(map[T]struct{})}
+	for v := range s.items {
+		if !other.Contains(v) {
+			out.items[v] = struct{}{}
+		}
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func sorted(s []int) []int {
+	slices.Sort(s)
+	return s
+}
+
+func TestSetBasics(t *testing.T) {
+	var s Set[int]
+	s.Add(1)
+	s.Add(2)
+	s.Add(2)
+
+	if s.Len() != 2 {
+		t.Fatalf("Len = %d, want 2", s.Len())
+	}
+	if !s.Contains(1) || s.Contains(3) {
+		t.Fatal("Contains reported wrong membership")
+	}
+
+	s.Remove(1)
+	s.Remove(99)
+	if got := sorted(s.Slice()); !slices.Equal(got, []int{2}) {
+		t.Fatalf("Slice = %v, want [2]", got)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := NewSet(1, 2, 3)
+	b := NewSet(2, 3, 4)
+
+	tests := []struct {
+		name string
+		got  *Set[int]
+		want []int
+	}{
+		{"union", a.Union(b), []int{1, 2, 3, 4}},
+		{"intersect", a.Intersect(b), []int{2, 3}},
+		{"difference", a.Difference(b), []int{1}},
+		{"difference reversed", b.Difference(a), []int{4}},
+		{"intersect disjoint", a.Intersect(NewSet(9)), []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sorted(tt.got.Slice()); !slices.Equal(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := sorted(a.Slice()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("operations mutated receiver: %v", got)
+	}
+	if got := sorted(b.Slice()); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Fatalf("operations mutated argument: %v", got)
+	}
+}
+
+func TestSetUnionWithEmptyIsCopy(t *testing.T) {
+	empty := NewSet[int]()
+	full := NewSet(1, 2)
+
+	for _, u := range []*Set[int]{empty.Union(full), full.Union(empty)} {
+		if u == full {
+			t.Fatal("Union returned an operand")
+		}
+		u.Add(3)
+		u.Remove(1)
+	}
+
+	if got := sorted(full.Slice()); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("mutating union changed operand: %v", got)
+	}
+	if empty.Len() != 0 {
+		t.Fatalf("mutating union changed empty operand: %v", empty.Slice())
+	}
+}
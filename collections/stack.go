@@ -0,0 +1,42 @@
+package collections
+
+// Stack is a last-in, first-out collection. The zero value is an empty
+// stack ready to use.
+type Stack[T any] struct {
+	items []T
+}
+
+// Push adds v to the top of the stack.
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop removes and returns the top value. It returns the zero value and false
+// if the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+
+	last := len(s.items) - 1
+	v := s.items[last]
+	s.items[last] = zero
+	s.items = s.items[:last]
+	return v, true
+}
+
+// Peek returns the top value without removing it. It returns the zero value
+// and false if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of values on the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
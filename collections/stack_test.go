@@ -0,0 +1,33 @@
+package collections
+
+import "testing"
+
+func TestStack(t *testing.T) {
+	var s Stack[int]
+
+	if v, ok := s.Pop(); ok || v != 0 {
+		t.Fatalf("Pop on empty = %d, %v; want 0, false", v, ok)
+	}
+	if v, ok := s.Peek(); ok || v != 0 {
+		t.Fatalf("Peek on empty = %d, %v; want 0, false", v, ok)
+	}
+
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+
+	if v, ok := s.Peek(); !ok || v != 3 {
+		t.Fatalf("Peek = %d, %v; want 3, true", v, ok)
+	}
+	for _, want := range []int{3, 2, 1} {
+		if v, ok := s.Pop(); !ok || v != want {
+			t.Fatalf("Pop = %d, %v; want %d, true", v, ok, want)
+		}
+	}
+	if s.Len() != 0 {
+		t.Fatalf("Len = %d, want 0", s.Len())
+	}
+	if _, ok := s.Pop(); ok {
+		t.Fatal("Pop on drained stack succeeded")
+	}
+}
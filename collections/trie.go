@@ -0,0 +1,97 @@
+package collections
+
+import "slices"
+
+type trieNode struct {
+	children map[rune]*trieNode
+	end      bool // a stored word ends at this node
+}
+
+// Trie is a prefix tree of strings, useful for prefix lookups such as
+// autocomplete. The empty string can be stored like any other word. The
+// zero value is an empty trie ready to use. A Trie is not safe for
+// concurrent use.
+type Trie struct {
+	root trieNode
+	size int
+}
+
+// Insert adds word to the trie. Inserting a word twice has no effect.
+func (t *Trie) Insert(word string) {
+	n := &t.root
+	for _, r := range word {
+		child, ok := n.children[r]
+		if !ok {
+			if n.children == nil {
+				n.children = make(map[rune]*trieNode)
+			}
+			child = &trieNode{}
+			n.children[r] = child
+		}
+		n = child
+	}
+
+	if !n.end {
+		n.end = true
+		t.size++
+	}
+}
+
+// Contains reports whether word itself was inserted. It returns false for
+// a word that is only a prefix of stored words.
+func (t *Trie) Contains(word string) bool {
+	n := t.find(word)
+	return n != nil && n.end
+}
+
+// Len returns the number of distinct words stored.
+func (t *Trie) Len() int {
+	return t.size
+}
+
+// WithPrefix returns every stored word that starts with prefix, including
+// prefix itself if stored, in lexicographic order. It returns an empty,
+// non-nil slice when nothing matches. An empty prefix matches every word.
+func (t *Trie) WithPrefix(prefix string) []string {
+	out := []string{}
+
+	n := t.find(prefix)
+	if n == nil {
+		return out
+	}
+
+	buf := []rune(prefix)
+	var walk func(n *trieNode)
+	walk = func(n *trieNode) {
+		if n.end {
+			out = append(out, string(buf))
+		}
+
+		keys := make([]rune, 0, len(n.children))
+		for r := range n.children {
+			keys = append(keys, r)
+		}
+		slices.Sort(keys)
+
+		for _, r := range keys {
+			buf = append(buf, r)
+			walk(n.children[r])
+			buf = buf[:len(buf)-1]
+		}
+	}
+	walk(n)
+
+	return out
+}
+
+// find returns the node reached by following word, or nil if there is none.
+func (t *Trie) find(word string) *trieNode {
+	n := &t.root
+	for _, r := range word {
+		n = n.children[r]
+		if n == nil {
+			return nil
+		}
+	}
+	return n
+}
@@ -0,0 +1,86 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTrieWithPrefix(t *testing.T) {
+	var tr Trie
+	for _, w := range []string{"car", "card", "care", "careful", "cat", "dog"} {
+		tr.Insert(w)
+	}
+	tr.Insert("car") // duplicate
+
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"car", []string{"car", "card", "care", "careful"}},
+		{"care", []string{"care", "careful"}},
+		{"ca", []string{"car", "card", "care", "careful", "cat"}},
+		{"d", []string{"dog"}},
+		{"", []string{"car", "card", "care", "careful", "cat", "dog"}},
+		{"x", []string{}},
+		{"cards", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			got := tr.WithPrefix(tt.prefix)
+			if got == nil {
+				t.Fatal("WithPrefix returned nil")
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("WithPrefix(%q) = %q, want %q", tt.prefix, got, tt.want)
+			}
+		})
+	}
+
+	if tr.Len() != 6 {
+		t.Fatalf("Len = %d, want 6", tr.Len())
+	}
+}
+
+func TestTrieContains(t *testing.T) {
+	var tr Trie
+	tr.Insert("car")
+	tr.Insert("card")
+
+	for word, want := range map[string]bool{
+		"car":  true,
+		"card": true,
+		"ca":   false, // prefix only
+		"cars": false,
+		"":     false,
+	} {
+		if got := tr.Contains(word); got != want {
+			t.Errorf("Contains(%q) = %v, want %v", word, got, want)
+		}
+	}
+}
+
+func TestTrieEmptyString(t *testing.T) {
+	var tr Trie
+	if got := tr.WithPrefix(""); got == nil || len(got) != 0 {
+		t.Fatalf("WithPrefix on empty trie = %#v, want empty non-nil", got)
+	}
+
+	tr.Insert("")
+	tr.Insert("a")
+	if !tr.Contains("") {
+		t.Fatal("empty string not stored")
+	}
+	if got, want := tr.WithPrefix(""), []string{"", "a"}; !slices.Equal(got, want) {
+		t.Fatalf("WithPrefix(\"\") = %q, want %q", got, want)
+	}
+}
+
+func TestTrieUnicode(t *testing.T) {
+	var tr Trie
+	tr.Insert("héllo")
+	tr.Insert("hélium")
+
+	if got, want := tr.WithPrefix("hé"), []string{"hélium", "héllo"}; !slices.Equal(got, want) {
+		t.Fatalf("WithPrefix = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,48 @@
+// Package future provides a one-shot, channel-backed future.
+package future
+
+import (
+	"context"
+	"sync"
+)
+
+// Future holds a result that becomes available once it is resolved. Any
+// number of goroutines may wait on it, and all of them observe the same
+// result.
+type Future[T any] struct {
+	done chan struct{}
+	once sync.Once
+	val  T
+	err  error
+}
+
+// NewFuture returns an unresolved future and the function that resolves it.
+// Only the first call to resolve takes effect; later calls are no-ops.
+func NewFuture[T any]() (*Future[T], func(T, error)) {
+	f := &Future[T]{done: make(chan struct{})}
+	return f, f.resolve
+}
+
+func (f *Future[T]) resolve(v T, err error) {
+	f.once.Do(func() {
+		f.val, f.err = v, err
+		close(f.done)
+	})
+}
+
+// Get blocks until the future is resolved and returns its value and error,
+// or returns the zero value and ctx.Err() if ctx is done first.
+func (f *Future[T]) Get(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.val, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Done returns a channel that is closed once the future is resolved.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
@@ -0,0 +1,77 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFutureConcurrentGetters(t *testing.T) {
+	f, resolve := NewFuture[int]()
+
+	const getters = 10
+	results := make(chan int, getters)
+	var wg sync.WaitGroup
+	for i := 0; i < getters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := f.Get(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			results <- v
+		}()
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	resolve(7, nil)
+	wg.Wait()
+	close(results)
+
+	for v := range results {
+		if v != 7 {
+			t.Fatalf("getter received %d, want 7", v)
+		}
+	}
+}
+
+func TestFutureResolveTwiceIsNoop(t *testing.T) {
+	f, resolve := NewFuture[string]()
+	boom := errors.New("boom")
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() { defer wg.Done(); resolve("first", nil) }()
+	go func() { defer wg.Done(); resolve("second", boom) }()
+	wg.Wait()
+
+	v1, err1 := f.Get(context.Background())
+	resolve("third", nil)
+	v2, err2 := f.Get(context.Background())
+
+	if v1 != v2 || err1 != err2 {
+		t.Fatalf("result changed after resolve: (%q, %v) then (%q, %v)", v1, err1, v2, err2)
+	}
+	if v1 == "third" {
+		t.Fatal("late resolve took effect")
+	}
+	select {
+	case <-f.Done():
+	default:
+		t.Fatal("Done not closed after resolve")
+	}
+}
+
+func TestFutureGetCancelled(t *testing.T) {
+	f, _ := NewFuture[int]()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := f.Get(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Get = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/Nasim043/go-realm
+
+go 1.22
@@ -0,0 +1,90 @@
+package kvstore
+
+import (
+	"sort"
+	"time"
+)
+
+// index maps an extracted field value to the keys of the records holding it.
+type index struct {
+	extract func(val []byte) string
+	keys    map[string]map[string]struct{}
+}
+
+func (ix *index) add(field, key string) {
+	set, ok := ix.keys[field]
+	if !ok {
+		set = make(map[string]struct{})
+		ix.keys[field] = set
+	}
+	set[key] = struct{}{}
+}
+
+func (ix *index) remove(field, key string) {
+	set := ix.keys[field]
+	delete(set, key)
+	if len(set) == 0 {
+		delete(ix.keys, field)
+	}
+}
+
+// CreateIndex registers a secondary index called name. extract derives the
+// indexed field from a stored value; it must be deterministic and should
+// not retain val. Existing records are indexed immediately, and the index
+// is kept up to date by every later Set, Delete, commit and expiry.
+// Creating an index with an existing name replaces it.
+func (s *Store) CreateIndex(name string, extract func(val []byte) string) {
+	ix := &index{extract: extract, keys: make(map[string]map[string]struct{})}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, it := range s.items {
+		ix.add(extract(it.val), key)
+	}
+	s.indexes[name] = ix
+}
+
+// FindByIndex returns copies of the values of all unexpired records whose
+// field in the named index equals field, ordered by key. It returns nil if
+// there are none or the index does not exist.
+func (s *Store) FindByIndex(name, field string) [][]byte {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ix, ok := s.indexes[name]
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(ix.keys[field]))
+	for key := range ix.keys[field] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var out [][]byte
+	now := time.Now()
+	for _, key := range keys {
+		if it := s.items[key]; !it.expired(now) {
+			out = append(out, clone(it.val))
+		}
+	}
+	return out
+}
+
+// index adds key, holding val, to every index. It must be called with s.mu
+// held for writing.
+func (s *Store) index(key string, val []byte) {
+	for _, ix := range s.indexes {
+		ix.add(ix.extract(val), key)
+	}
+}
+
+// unindex removes key, previously holding val, from every index. It must be
+// called with s.mu held for writing.
+func (s *Store) unindex(key string, val []byte) {
+	for _, ix := range s.indexes {
+		ix.remove(ix.extract(val), key)
+	}
+}
@@ -0,0 +1,51 @@
+package kvstore
+
+import (
+	"strings"
+	"testing"
+)
+
+// city extracts the part of a "name:city" value after the colon.
+func city(val []byte) string {
+	_, c, _ := strings.Cut(string(val), ":")
+	return c
+}
+
+func values(vals [][]byte) []string {
+	out := make([]string, len(vals))
+	for i, v := range vals {
+		out[i] = string(v)
+	}
+	return out
+}
+
+func TestFindByIndex(t *testing.T) {
+	s := NewStore()
+	defer s.Close()
+
+	s.Set("u1", []byte("ann:paris"), 0)
+	s.CreateIndex("city", city) // indexes existing records
+	s.Set("u2", []byte("bob:paris"), 0)
+	s.Set("u3", []byte("cid:rome"), 0)
+
+	if got, want := strings.Join(values(s.FindByIndex("city", "paris")), ","), "ann:paris,bob:paris"; got != want {
+		t.Fatalf("FindByIndex(paris) = %q, want %q", got, want)
+	}
+
+	// Moving u1 to rome must drop it from the old field.
+	s.Set("u1", []byte("ann:rome"), 0)
+	if got, want := strings.Join(values(s.FindByIndex("city", "paris")), ","), "bob:paris"; got != want {
+		t.Fatalf("FindByIndex(paris) after update = %q, want %q", got, want)
+	}
+	if got, want := strings.Join(values(s.FindByIndex("city", "rome")), ","), "ann:rome,cid:rome"; got != want {
+		t.Fatalf("FindByIndex(rome) after update = %q, want %q", got, want)
+	}
+
+	s.Delete("u2")
+	if got := s.FindByIndex("city", "paris"); got != nil {
+		t.Fatalf("FindByIndex(paris) after delete = %q, want nil", values(got))
+	}
+	if got := s.FindByIndex("missing", "rome"); got != nil {
+		t.Fatalf("FindByIndex on unknown index = %q, want nil", values(got))
+	}
+}
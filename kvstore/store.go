@@ -0,0 +1,216 @@
+// Package kvstore implements a small in-memory key-value store.
+package kvstore
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the background sweeper removes expired keys.
+const sweepInterval = time.Second
+
+type item struct {
+	val     []byte
+	expires time.Time // zero means the item never expires
+}
+
+func (it item) expired(now time.Time) bool {
+	return !it.expires.IsZero() && !now.Before(it.expires)
+}
+
+// Store is a concurrency-safe in-memory key-value store with optional
+// per-key expiry. Expired keys are removed lazily on Get and periodically
+// by a background sweeper.
+type Store struct {
+	mu      sync.RWMutex
+	items   map[string]item
+	indexes map[string]*index
+	wal     *wal  // nil unless created by NewStoreWithWAL
+	walErr  error // first write-ahead log failure
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewStore returns an empty store and starts its sweeper. Call Close to stop
+// the sweeper when the store is no longer needed.
+func NewStore() *Store {
+	s := &Store{
+		items:   make(map[string]item),
+		indexes: make(map[string]*index),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	go s.sweep()
+
+	return s
+}
+
+// Set stores a copy of val under key. A positive ttl makes the key expire
+// after that duration; otherwise it never expires.
+//
+// On a store with a write-ahead log, a write that cannot be logged is
+// dropped and only reported by Err. Use SetErr to see the failure directly.
+func (s *Store) Set(key string, val []byte, ttl time.Duration) {
+	s.SetErr(key, val, ttl)
+}
+
+// SetErr is like Set but returns the error if the write could not be logged,
+// in which case the store is unchanged.
+func (s *Store) SetErr(key string, val []byte, ttl time.Duration) error {
+	r := setRecord(key, clone(val), ttl)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.writeLocked([]record{r})
+}
+
+// setLocked stores it under key. It must be called with s.mu held for
+// writing.
+func (s *Store) setLocked(key string, it item) {
+	if old, ok := s.items[key]; ok {
+		s.unindex(key, old.val)
+	}
+	s.items[key] = it
+	s.index(key, it.val)
+}
+
+// Get returns a copy of the value stored under key. Expired keys are
+// reported as missing and removed.
+func (s *Store) Get(key string) ([]byte, bool) {
+	s.mu.RLock()
+	it, ok := s.items[key]
+	s.mu.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+	if it.expired(time.Now()) {
+		s.mu.Lock()
+		// Re-check under the write lock in case key was set again.
+		if cur, ok := s.items[key]; ok && cur.expired(time.Now()) {
+			s.deleteLocked(key)
+		}
+		s.mu.Unlock()
+		return nil, false
+	}
+
+	return clone(it.val), true
+}
+
+// Delete removes key from the store. Deleting a missing key is a no-op.
+//
+// As with Set, a delete that cannot be logged is dropped and only reported
+// by Err. Use DeleteErr to see the failure directly.
+func (s *Store) Delete(key string) {
+	s.DeleteErr(key)
+}
+
+// DeleteErr is like Delete but returns the error if the delete could not be
+// logged, in which case the store is unchanged.
+func (s *Store) DeleteErr(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.writeLocked([]record{{key: key, deleted: true}})
+}
+
+// deleteLocked removes key. It must be called with s.mu held for writing.
+func (s *Store) deleteLocked(key string) {
+	if old, ok := s.items[key]; ok {
+		s.unindex(key, old.val)
+		delete(s.items, key)
+	}
+}
+
+// writeLocked appends recs to the write-ahead log, if there is one, and then
+// applies them. If the log cannot be written, recs are discarded and the
+// error is returned and remembered for Err. It must be called with s.mu held
+// for writing.
+func (s *Store) writeLocked(recs []record) error {
+	if s.wal != nil {
+		if err := s.wal.append(recs); err != nil {
+			if s.walErr == nil {
+				s.walErr = err
+			}
+			return err
+		}
+	}
+	s.applyLocked(recs)
+	return nil
+}
+
+// applyLocked applies recs in order. A set whose expiry has passed acts as a
+// delete. It must be called with s.mu held for writing.
+func (s *Store) applyLocked(recs []record) {
+	now := time.Now()
+	for _, r := range recs {
+		it := item{val: r.val, expires: r.expires}
+		if r.deleted || it.expired(now) {
+			s.deleteLocked(r.key)
+		} else {
+			s.setLocked(r.key, it)
+		}
+	}
+}
+
+// Err returns the first error encountered writing the write-ahead log, or
+// nil. Writes that failed to reach the log were not applied.
+func (s *Store) Err() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.walErr
+}
+
+// Close stops the background sweeper, waits for it to exit and closes the
+// write-ahead log, if any. It is safe to call Close more than once; only the
+// first call can return an error.
+func (s *Store) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+		if s.wal != nil {
+			err = s.wal.close()
+		}
+	})
+	<-s.done
+	return err
+}
+
+func (s *Store) sweep() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			s.removeExpired(now)
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+func (s *Store) removeExpired(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, it := range s.items {
+		if it.expired(now) {
+			s.deleteLocked(key)
+		}
+	}
+}
+
+func clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	out := make([]byte, len(b))
+	copy(out, b)
+	return out
+}
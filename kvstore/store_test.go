@@ -0,0 +1,97 @@
+package kvstore
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStoreSetGetDelete(t *testing.T) {
+	s := NewStore()
+	defer s.Close()
+
+	val := []byte("v1")
+	s.Set("k", val, 0)
+	val[0] = 'x' // the store keeps its own copy
+
+	got, ok := s.Get("k")
+	if !ok || string(got) != "v1" {
+		t.Fatalf("Get = %q, %v; want %q, true", got, ok, "v1")
+	}
+
+	got[0] = 'y'
+	if again, _ := s.Get("k"); string(again) != "v1" {
+		t.Fatalf("Get returned shared storage: %q", again)
+	}
+
+	s.Delete("k")
+	s.Delete("missing")
+	if _, ok := s.Get("k"); ok {
+		t.Fatal("key still present after Delete")
+	}
+}
+
+func TestStoreTTL(t *testing.T) {
+	s := NewStore()
+	defer s.Close()
+
+	s.Set("short", []byte("x"), 50*time.Millisecond)
+	s.Set("forever", []byte("y"), 0)
+
+	if _, ok := s.Get("short"); !ok {
+		t.Fatal("key missing before its TTL")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if _, ok := s.Get("short"); ok {
+		t.Fatal("key still present after its TTL")
+	}
+	if _, ok := s.Get("forever"); !ok {
+		t.Fatal("key without TTL expired")
+	}
+}
+
+func TestStoreSweeperRemovesExpired(t *testing.T) {
+	s := NewStore()
+	defer s.Close()
+
+	s.Set("k", []byte("x"), time.Millisecond)
+	s.removeExpired(time.Now().Add(time.Second))
+
+	s.mu.RLock()
+	n := len(s.items)
+	s.mu.RUnlock()
+	if n != 0 {
+		t.Fatalf("sweep left %d items", n)
+	}
+}
+
+func TestStoreConcurrent(t *testing.T) {
+	s := NewStore()
+	defer s.Close()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				key := fmt.Sprint(i % 10)
+				s.Set(key, []byte{byte(g)}, time.Millisecond)
+				s.Get(key)
+				if i%3 == 0 {
+					s.Delete(key)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestStoreCloseTwice(t *testing.T) {
+	s := NewStore()
+	s.Close()
+	s.Close()
+}
@@ -0,0 +1,99 @@
+package kvstore
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTxnDone is returned when a transaction is used after it has been
+// committed or rolled back.
+var ErrTxnDone = errors.New("kvstore: transaction already committed or rolled back")
+
+// op is a buffered write; deleted marks a buffered Delete.
+type op struct {
+	val     []byte
+	ttl     time.Duration
+	deleted bool
+}
+
+// Txn buffers writes against a Store and applies them atomically on
+// Commit. Reads through a Txn see its own uncommitted writes. A Txn is not
+// safe for concurrent use.
+type Txn struct {
+	store  *Store
+	writes map[string]op
+	done   bool
+}
+
+// Begin starts a new transaction against s.
+func (s *Store) Begin() *Txn {
+	return &Txn{store: s, writes: make(map[string]op)}
+}
+
+// Set buffers a write of val under key. The ttl is measured from Commit.
+func (t *Txn) Set(key string, val []byte, ttl time.Duration) error {
+	if t.done {
+		return ErrTxnDone
+	}
+	t.writes[key] = op{val: clone(val), ttl: ttl}
+	return nil
+}
+
+// Delete buffers the removal of key.
+func (t *Txn) Delete(key string) error {
+	if t.done {
+		return ErrTxnDone
+	}
+	t.writes[key] = op{deleted: true}
+	return nil
+}
+
+// Get returns the value of key as seen by the transaction: its own pending
+// write if there is one, otherwise the committed value in the store.
+func (t *Txn) Get(key string) ([]byte, bool) {
+	if o, ok := t.writes[key]; ok {
+		if o.deleted {
+			return nil, false
+		}
+		return clone(o.val), true
+	}
+	return t.store.Get(key)
+}
+
+// Commit applies every buffered write to the store under a single lock, so
+// other readers observe either none or all of them. With a write-ahead log
+// the writes are logged as one record, so a restart also recovers all or
+// none of them; if logging fails, nothing is applied and the error is
+// returned.
+func (t *Txn) Commit() error {
+	if t.done {
+		return ErrTxnDone
+	}
+	t.done = true
+
+	recs := make([]record, 0, len(t.writes))
+	for key, o := range t.writes {
+		if o.deleted {
+			recs = append(recs, record{key: key, deleted: true})
+		} else {
+			recs = append(recs, setRecord(key, o.val, o.ttl))
+		}
+	}
+	t.writes = nil
+
+	s := t.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.writeLocked(recs)
+}
+
+// Rollback discards every buffered write.
+func (t *Txn) Rollback() error {
+	if t.done {
+		return ErrTxnDone
+	}
+	t.done = true
+	t.writes = nil
+	return nil
+}
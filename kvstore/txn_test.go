@@ -0,0 +1,99 @@
+package kvstore
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTxnCommitVisibility(t *testing.T) {
+	s := NewStore()
+	defer s.Close()
+	s.Set("old", []byte("1"), 0)
+
+	tx := s.Begin()
+	tx.Set("new", []byte("2"), 0)
+	tx.Delete("old")
+
+	if _, ok := s.Get("new"); ok {
+		t.Fatal("uncommitted write visible outside the transaction")
+	}
+	if _, ok := s.Get("old"); !ok {
+		t.Fatal("uncommitted delete visible outside the transaction")
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	if v, ok := s.Get("new"); !ok || string(v) != "2" {
+		t.Fatalf("Get(new) after commit = %q, %v", v, ok)
+	}
+	if _, ok := s.Get("old"); ok {
+		t.Fatal("committed delete not applied")
+	}
+}
+
+func TestTxnReadsOwnWrites(t *testing.T) {
+	s := NewStore()
+	defer s.Close()
+	s.Set("a", []byte("store"), 0)
+	s.Set("b", []byte("store"), 0)
+
+	tx := s.Begin()
+	tx.Set("a", []byte("txn"), 0)
+	tx.Delete("b")
+
+	if v, ok := tx.Get("a"); !ok || string(v) != "txn" {
+		t.Fatalf("tx.Get(a) = %q, %v; want own write", v, ok)
+	}
+	if _, ok := tx.Get("b"); ok {
+		t.Fatal("tx.Get(b) should see own delete")
+	}
+	if v, ok := tx.Get("a"); ok {
+		v[0] = 'X'
+		if again, _ := tx.Get("a"); string(again) != "txn" {
+			t.Fatal("tx.Get returned shared storage")
+		}
+	}
+	tx.Rollback()
+}
+
+func TestTxnRollbackDiscards(t *testing.T) {
+	s := NewStore()
+	defer s.Close()
+
+	tx := s.Begin()
+	tx.Set("k", []byte("v"), 0)
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback: %v", err)
+	}
+	if _, ok := s.Get("k"); ok {
+		t.Fatal("rolled back write visible")
+	}
+}
+
+func TestTxnDone(t *testing.T) {
+	s := NewStore()
+	defer s.Close()
+
+	rolledBack := s.Begin()
+	rolledBack.Rollback()
+	if err := rolledBack.Commit(); !errors.Is(err, ErrTxnDone) {
+		t.Fatalf("Commit after Rollback = %v, want ErrTxnDone", err)
+	}
+
+	committed := s.Begin()
+	committed.Set("k", []byte("v"), 0)
+	committed.Commit()
+	if err := committed.Rollback(); !errors.Is(err, ErrTxnDone) {
+		t.Fatalf("Rollback after Commit = %v, want ErrTxnDone", err)
+	}
+	if err := committed.Set("k", []byte("other"), 0); !errors.Is(err, ErrTxnDone) {
+		t.Fatalf("Set after Commit = %v, want ErrTxnDone", err)
+	}
+	if err := committed.Delete("k"); !errors.Is(err, ErrTxnDone) {
+		t.Fatalf("Delete after Commit = %v, want ErrTxnDone", err)
+	}
+	if v, _ := s.Get("k"); string(v) != "v" {
+		t.Fatalf("store corrupted after reuse of finished txn: %q", v)
+	}
+}
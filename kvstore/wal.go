@@ -0,0 +1,284 @@
+package kvstore
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"time"
+)
+
+// record is a single logged write: a set of key to val, or a delete.
+type record struct {
+	key     string
+	val     []byte
+	expires time.Time // zero means the value never expires
+	deleted bool
+}
+
+// setRecord returns a record setting key to val, expiring after ttl if it
+// is positive.
+func setRecord(key string, val []byte, ttl time.Duration) record {
+	r := record{key: key, val: val}
+	if ttl > 0 {
+		r.expires = time.Now().Add(ttl)
+	}
+	return r
+}
+
+// Each write-ahead log entry is framed as
+//
+//	length uint32 | crc32 uint32 | payload
+//
+// with both header fields little-endian and the checksum covering the
+// payload. The payload is a uvarint record count followed by the records,
+// each encoded as
+//
+//	kind byte | expires varint (Unix ns, 0 for none) | key | val
+//
+// where key and val are uvarint length-prefixed byte strings. One entry
+// holds all the records of a single Set, Delete or Txn commit, so each is
+// recovered in full or not at all.
+const (
+	walHeaderSize = 8
+	walMaxEntry   = 1 << 30
+
+	kindSet    byte = 1
+	kindDelete byte = 2
+)
+
+var errCorruptEntry = errors.New("kvstore: corrupt write-ahead log entry")
+
+// wal is an append-only write-ahead log file.
+type wal struct {
+	f   *os.File
+	off int64 // end of the last intact entry
+	err error // set once a failed append could not be undone
+}
+
+// NewStoreWithWAL returns a store backed by the write-ahead log at path,
+// creating the file if needed. Existing entries are replayed to rebuild the
+// store's contents, skipping values that have since expired. Replay stops at
+// the first entry that is truncated or fails its checksum, as left by a
+// crash mid-write; that entry and anything after it are cut from the file
+// so new entries append cleanly.
+//
+// Every write is appended to the log and synced to disk before it is
+// applied in memory. Call Close to stop the sweeper and close the log.
+func NewStoreWithWAL(path string) (*Store, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("kvstore: open write-ahead log: %w", err)
+	}
+
+	s := NewStore()
+	s.wal = &wal{f: f}
+
+	good, err := s.replay(f)
+	if err == nil {
+		err = f.Truncate(good)
+	}
+	if err == nil {
+		_, err = f.Seek(good, io.SeekStart)
+	}
+	if err != nil {
+		s.Close()
+		return nil, fmt.Errorf("kvstore: replay write-ahead log: %w", err)
+	}
+	s.wal.off = good
+
+	return s, nil
+}
+
+// replay applies every intact entry read from r and returns the offset just
+// past the last one.
+func (s *Store) replay(r io.Reader) (int64, error) {
+	br := bufio.NewReader(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var good int64
+	for {
+		recs, n, err := readEntry(br)
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+				errors.Is(err, errCorruptEntry) {
+				return good, nil
+			}
+			return good, err
+		}
+		s.applyLocked(recs)
+		good += n
+	}
+}
+
+// append writes recs as a single entry and syncs the file. If that fails,
+// the file is cut back to the end of the previous entry so that a partial
+// entry cannot hide later ones from replay. If even that fails, the log is
+// left unusable and every later append returns the error.
+func (w *wal) append(recs []record) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	payload := encodeRecords(recs)
+
+	buf := make([]byte, walHeaderSize, walHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(payload))
+	buf = append(buf, payload...)
+
+	err := w.write(buf)
+	if err != nil {
+		if rerr := w.rewind(); rerr != nil {
+			w.err = fmt.Errorf("kvstore: write-ahead log unusable after failed append: %w", rerr)
+		}
+		return err
+	}
+	w.off += int64(len(buf))
+	return nil
+}
+
+func (w *wal) write(buf []byte) error {
+	if _, err := w.f.Write(buf); err != nil {
+		return fmt.Errorf("kvstore: append to write-ahead log: %w", err)
+	}
+	if err := w.f.Sync(); err != nil {
+		return fmt.Errorf("kvstore: sync write-ahead log: %w", err)
+	}
+	return nil
+}
+
+// rewind discards anything written past the last intact entry.
+func (w *wal) rewind() error {
+	if err := w.f.Truncate(w.off); err != nil {
+		return err
+	}
+	_, err := w.f.Seek(w.off, io.SeekStart)
+	return err
+}
+
+func (w *wal) close() error {
+	return w.f.Close()
+}
+
+func encodeRecords(recs []record) []byte {
+	buf := binary.AppendUvarint(nil, uint64(len(recs)))
+	for _, r := range recs {
+		kind := kindSet
+		if r.deleted {
+			kind = kindDelete
+		}
+
+		var expires int64
+		if !r.expires.IsZero() {
+			expires = r.expires.UnixNano()
+		}
+
+		buf = append(buf, kind)
+		buf = binary.AppendVarint(buf, expires)
+		buf = binary.AppendUvarint(buf, uint64(len(r.key)))
+		buf = append(buf, r.key...)
+		buf = binary.AppendUvarint(buf, uint64(len(r.val)))
+		buf = append(buf, r.val...)
+	}
+	return buf
+}
+
+// readEntry reads and decodes one entry, returning its records and its size
+// in bytes. A cleanly missing entry yields io.EOF, a short one
+// io.ErrUnexpectedEOF and a damaged one errCorruptEntry.
+func readEntry(r io.Reader) ([]record, int64, error) {
+	var header [walHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, 0, err
+	}
+
+	size := binary.LittleEndian.Uint32(header[0:4])
+	if size > walMaxEntry {
+		return nil, 0, errCorruptEntry
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, 0, err
+	}
+	if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(header[4:8]) {
+		return nil, 0, errCorruptEntry
+	}
+
+	recs, err := decodeRecords(payload)
+	if err != nil {
+		return nil, 0, err
+	}
+	return recs, walHeaderSize + int64(size), nil
+}
+
+func decodeRecords(b []byte) ([]record, error) {
+	count, n := binary.Uvarint(b)
+	if n <= 0 || count > uint64(len(b)) {
+		return nil, errCorruptEntry
+	}
+	b = b[n:]
+
+	recs := make([]record, 0, count)
+	for i := uint64(0); i < count; i++ {
+		if len(b) == 0 {
+			return nil, errCorruptEntry
+		}
+		kind := b[0]
+		b = b[1:]
+
+		expires, n := binary.Varint(b)
+		if n <= 0 {
+			return nil, errCorruptEntry
+		}
+		b = b[n:]
+
+		key, rest, ok := readBytes(b)
+		if !ok {
+			return nil, errCorruptEntry
+		}
+		val, rest, ok := readBytes(rest)
+		if !ok {
+			return nil, errCorruptEntry
+		}
+		b = rest
+
+		r := record{key: string(key)}
+		switch kind {
+		case kindSet:
+			r.val = val
+			if expires != 0 {
+				r.expires = time.Unix(0, expires)
+			}
+		case kindDelete:
+			r.deleted = true
+		default:
+			return nil, errCorruptEntry
+		}
+		recs = append(recs, r)
+	}
+
+	if len(b) != 0 {
+		return nil, errCorruptEntry
+	}
+	return recs, nil
+}
+
+// readBytes splits a uvarint length-prefixed byte string off the front of b.
+func readBytes(b []byte) (data, rest []byte, ok bool) {
+	size, n := binary.Uvarint(b)
+	if n <= 0 || size > uint64(len(b)-n) {
+		return nil, nil, false
+	}
+	b = b[n:]
+	return b[:size:size], b[size:], true
+}
@@ -0,0 +1,195 @@
+package kvstore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func openWAL(t *testing.T, path string) *Store {
+	t.Helper()
+	s, err := NewStoreWithWAL(path)
+	if err != nil {
+		t.Fatalf("NewStoreWithWAL: %v", err)
+	}
+	return s
+}
+
+func closeWAL(t *testing.T, s *Store) {
+	t.Helper()
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+// wantKeys checks each key of want against s; an empty value means the key
+// must be missing.
+func wantKeys(t *testing.T, s *Store, want map[string]string) {
+	t.Helper()
+	for key, val := range want {
+		got, ok := s.Get(key)
+		switch {
+		case val == "" && ok:
+			t.Errorf("Get(%q) = %q, want missing", key, got)
+		case val != "" && (!ok || string(got) != val):
+			t.Errorf("Get(%q) = %q, %v; want %q, true", key, got, ok, val)
+		}
+	}
+}
+
+func TestWALRecover(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.wal")
+
+	s := openWAL(t, path)
+	if err := s.SetErr("a", []byte("1"), 0); err != nil {
+		t.Fatalf("SetErr: %v", err)
+	}
+	s.Set("b", []byte("2"), time.Hour)
+	s.Set("a", []byte("3"), 0)
+	if err := s.DeleteErr("b"); err != nil {
+		t.Fatalf("DeleteErr: %v", err)
+	}
+	s.Set("gone", []byte("x"), 10*time.Millisecond)
+
+	txn := s.Begin()
+	txn.Set("t1", []byte("x"), 0)
+	txn.Set("t2", []byte("y"), 0)
+	if err := txn.Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	closeWAL(t, s)
+
+	time.Sleep(20 * time.Millisecond) // let "gone" expire on disk
+
+	s = openWAL(t, path)
+	wantKeys(t, s, map[string]string{"a": "3", "b": "", "gone": "", "t1": "x", "t2": "y"})
+
+	// Appends after recovery land after the replayed entries.
+	s.Set("c", []byte("4"), 0)
+	closeWAL(t, s)
+
+	s = openWAL(t, path)
+	defer s.Close()
+	wantKeys(t, s, map[string]string{"a": "3", "c": "4", "t1": "x", "t2": "y"})
+}
+
+func TestWALDamagedTail(t *testing.T) {
+	tests := []struct {
+		name   string
+		damage func(data []byte, last int) []byte
+		keepB  bool // whether the last entry survives
+	}{
+		{"truncated header", func(data []byte, last int) []byte { return data[:last+3] }, false},
+		{"truncated payload", func(data []byte, last int) []byte { return data[:len(data)-1] }, false},
+		{"bad checksum", func(data []byte, last int) []byte {
+			data[len(data)-1] ^= 0xff
+			return data
+		}, false},
+		{"trailing garbage", func(data []byte, last int) []byte {
+			return append(data, 0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0)
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "store.wal")
+
+			s := openWAL(t, path)
+			s.Set("a", []byte("1"), 0)
+			closeWAL(t, s)
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			good := int(info.Size())
+
+			s = openWAL(t, path)
+			s.Set("b", []byte("2"), 0)
+			closeWAL(t, s)
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, tt.damage(data, good), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			s = openWAL(t, path)
+			want := map[string]string{"a": "1", "b": ""}
+			if tt.keepB {
+				want["b"] = "2"
+			}
+			wantKeys(t, s, want)
+
+			// The damaged entry is cut so new entries are recovered.
+			s.Set("c", []byte("3"), 0)
+			closeWAL(t, s)
+
+			s = openWAL(t, path)
+			defer s.Close()
+			want["c"] = "3"
+			wantKeys(t, s, want)
+		})
+	}
+}
+
+func TestWALRewind(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.wal")
+
+	s := openWAL(t, path)
+	s.Set("a", []byte("1"), 0)
+
+	// Simulate a partial entry left by a failed write, then undo it as a
+	// failed append does.
+	if _, err := s.wal.f.Write([]byte{9, 0, 0, 0, 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.wal.rewind(); err != nil {
+		t.Fatalf("rewind: %v", err)
+	}
+	s.Set("b", []byte("2"), 0)
+	closeWAL(t, s)
+
+	s = openWAL(t, path)
+	defer s.Close()
+	wantKeys(t, s, map[string]string{"a": "1", "b": "2"})
+}
+
+func TestWALFailedAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.wal")
+
+	s := openWAL(t, path)
+	s.Set("a", []byte("1"), 0)
+
+	// A read-only handle makes both the append and the rewind fail.
+	ro, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rw := s.wal.f
+	s.wal.f = ro
+	defer rw.Close()
+
+	err = s.SetErr("b", []byte("2"), 0)
+	if err == nil {
+		t.Fatal("SetErr on a read-only log succeeded")
+	}
+	if s.Err() != err {
+		t.Fatalf("Err = %v, want %v", s.Err(), err)
+	}
+	s.Delete("a") // dropped
+	wantKeys(t, s, map[string]string{"a": "1", "b": ""})
+
+	if err := s.DeleteErr("a"); err == nil || !strings.Contains(err.Error(), "unusable") {
+		t.Fatalf("DeleteErr after failed rewind = %v, want unusable log error", err)
+	}
+	txn := s.Begin()
+	txn.Set("c", []byte("3"), 0)
+	if err := txn.Commit(); err == nil {
+		t.Fatal("Commit on an unusable log succeeded")
+	}
+	wantKeys(t, s, map[string]string{"a": "1", "c": ""})
+	s.Close()
+}
@@ -0,0 +1,90 @@
+// Package lifecycle coordinates application startup and shutdown.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ShutdownGroup collects cleanup functions and runs them in reverse order
+// of registration when Shutdown is called. The zero value is not usable;
+// create one with NewShutdownGroup.
+type ShutdownGroup struct {
+	mu      sync.Mutex
+	fns     []func(ctx context.Context) error
+	started bool
+	once    sync.Once
+	done    chan struct{}
+	err     error
+}
+
+// NewShutdownGroup returns an empty group.
+func NewShutdownGroup() *ShutdownGroup {
+	return &ShutdownGroup{done: make(chan struct{})}
+}
+
+// Register adds fn to the group. Functions registered after Shutdown has
+// been called are ignored.
+func (g *ShutdownGroup) Register(fn func(ctx context.Context) error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.started {
+		return
+	}
+	g.fns = append(g.fns, fn)
+}
+
+// Shutdown runs the registered functions one at a time, last registered
+// first, passing each the given context. Once ctx is done, the remaining
+// functions are skipped.
+//
+// Shutdown returns when every function has completed, with their errors
+// joined, or when ctx is done, with ctx.Err(). In the latter case a running
+// function is not interrupted; Done reports when it has finished. Calling
+// Shutdown again waits on the same run.
+func (g *ShutdownGroup) Shutdown(ctx context.Context) error {
+	g.once.Do(func() {
+		g.mu.Lock()
+		g.started = true
+		fns := g.fns
+		g.mu.Unlock()
+
+		go g.run(ctx, fns)
+	})
+
+	select {
+	case <-g.done:
+		return g.err
+	case <-ctx.Done():
+		select {
+		case <-g.done:
+			return g.err
+		default:
+			return ctx.Err()
+		}
+	}
+}
+
+// Done returns a channel that is closed once Shutdown has finished running
+// or skipping every registered function.
+func (g *ShutdownGroup) Done() <-chan struct{} {
+	return g.done
+}
+
+func (g *ShutdownGroup) run(ctx context.Context, fns []func(ctx context.Context) error) {
+	defer close(g.done)
+
+	var errs []error
+	for i := len(fns) - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if err := fns[i](ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	g.err = errors.Join(errs...)
+}
@@ -0,0 +1,95 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestShutdownLIFO(t *testing.T) {
+	g := NewShutdownGroup()
+
+	var order []int
+	for i := 1; i <= 3; i++ {
+		g.Register(func(context.Context) error {
+			order = append(order, i)
+			return nil
+		})
+	}
+
+	if err := g.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	select {
+	case <-g.Done():
+	default:
+		t.Fatal("Done not closed after Shutdown returned")
+	}
+	if want := []int{3, 2, 1}; !slices.Equal(order, want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+}
+
+func TestShutdownJoinsErrors(t *testing.T) {
+	g := NewShutdownGroup()
+	errA, errB := errors.New("a"), errors.New("b")
+	g.Register(func(context.Context) error { return errA })
+	g.Register(func(context.Context) error { return nil })
+	g.Register(func(context.Context) error { return errB })
+
+	err := g.Shutdown(context.Background())
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Fatalf("Shutdown = %v, want both errors", err)
+	}
+}
+
+func TestShutdownDeadline(t *testing.T) {
+	g := NewShutdownGroup()
+
+	ran := false
+	g.Register(func(context.Context) error {
+		ran = true
+		return nil
+	})
+	g.Register(func(context.Context) error {
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := g.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Shutdown = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	select {
+	case <-g.Done():
+		t.Fatal("Done closed while a cleanup was still running")
+	default:
+	}
+
+	select {
+	case <-g.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Done never closed")
+	}
+	if ran {
+		t.Fatal("cleanup after the deadline still ran")
+	}
+}
+
+func TestShutdownIgnoresLateRegister(t *testing.T) {
+	g := NewShutdownGroup()
+	if err := g.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	g.Register(func(context.Context) error { return errors.New("late") })
+	if err := g.Shutdown(context.Background()); err != nil {
+		t.Fatalf("second Shutdown = %v, want nil", err)
+	}
+}
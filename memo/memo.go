@@ -0,0 +1,63 @@
+// Package memo caches the results of pure functions.
+package memo
+
+import "sync"
+
+type call[V any] struct {
+	done chan struct{}
+	val  V
+	ok   bool // fn returned normally and val is valid
+}
+
+// Memoize returns a function that calls fn at most once per distinct key
+// and caches the result forever. It is safe for concurrent use: concurrent
+// callers asking for a key that is still being computed wait for that
+// computation instead of starting their own.
+//
+// The cache lock is not held while fn runs, so fn may itself call the
+// memoized function for other keys, as in a recursive Fibonacci. Calling it
+// for the key currently being computed deadlocks, just as that recursion
+// would never terminate without memoization.
+//
+// If fn panics, the panic propagates to the caller that ran it and nothing
+// is cached; callers that were waiting on that computation retry it.
+func Memoize[K comparable, V any](fn func(K) V) func(K) V {
+	var (
+		mu    sync.Mutex
+		calls = make(map[K]*call[V])
+	)
+
+	return func(k K) V {
+		for {
+			mu.Lock()
+			c, ok := calls[k]
+			if !ok {
+				break // keep mu held to register the call below
+			}
+			mu.Unlock()
+
+			<-c.done
+			if c.ok {
+				return c.val
+			}
+			// The computation panicked and was forgotten; try again.
+		}
+
+		c := &call[V]{done: make(chan struct{})}
+		calls[k] = c
+		mu.Unlock()
+
+		defer func() {
+			if !c.ok {
+				mu.Lock()
+				delete(calls, k)
+				mu.Unlock()
+			}
+			close(c.done)
+		}()
+
+		c.val = fn(k)
+		c.ok = true
+		return c.val
+	}
+}
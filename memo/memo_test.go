@@ -0,0 +1,143 @@
+package memo
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoizeCachesResults(t *testing.T) {
+	var calls atomic.Int32
+	square := Memoize(func(n int) int {
+		calls.Add(1)
+		return n * n
+	})
+
+	for i := 0; i < 3; i++ {
+		if got := square(4); got != 16 {
+			t.Fatalf("square(4) = %d, want 16", got)
+		}
+	}
+	square(5)
+
+	if n := calls.Load(); n != 2 {
+		t.Fatalf("fn called %d times, want 2", n)
+	}
+}
+
+func TestMemoizeSingleFlight(t *testing.T) {
+	var calls atomic.Int32
+	slow := Memoize(func(s string) int {
+		calls.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		return len(s)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := slow("hello"); got != 5 {
+				t.Errorf("slow(hello) = %d, want 5", got)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("fn called %d times for concurrent callers, want 1", n)
+	}
+}
+
+func TestMemoizeRecursiveFibonacci(t *testing.T) {
+	var calls atomic.Int32
+	var fib func(int) int
+	fib = Memoize(func(n int) int {
+		calls.Add(1)
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+
+	done := make(chan int)
+	go func() { done <- fib(60) }()
+
+	select {
+	case got := <-done:
+		if got != 1548008755920 {
+			t.Fatalf("fib(60) = %d, want 1548008755920", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("recursive memoized call deadlocked")
+	}
+	if n := calls.Load(); n != 61 {
+		t.Fatalf("fn called %d times, want 61", n)
+	}
+}
+
+func TestMemoizePanicIsNotCached(t *testing.T) {
+	var calls atomic.Int32
+	flaky := Memoize(func(n int) int {
+		if calls.Add(1) == 1 {
+			panic("first call fails")
+		}
+		return n
+	})
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("panic not propagated to caller")
+			}
+		}()
+		flaky(1)
+	}()
+
+	done := make(chan int)
+	go func() { done <- flaky(1) }()
+	select {
+	case got := <-done:
+		if got != 1 {
+			t.Fatalf("flaky(1) = %d, want 1", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("call after a panic hung")
+	}
+}
+
+func TestMemoizePanicReleasesWaiters(t *testing.T) {
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	fn := Memoize(func(n int) int {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-release
+			panic("boom")
+		}
+		return n * 10
+	})
+
+	go func() {
+		defer func() { recover() }()
+		fn(1)
+	}()
+	<-started
+
+	done := make(chan int)
+	go func() { done <- fn(1) }()
+	time.Sleep(10 * time.Millisecond) // let the waiter block on the first call
+	close(release)
+
+	select {
+	case got := <-done:
+		if got != 10 {
+			t.Fatalf("waiter got %d, want 10", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("waiter hung after the computation panicked")
+	}
+}
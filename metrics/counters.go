@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Counters is a registry of named int64 counters, created on first use. It
+// is safe for concurrent use. The zero value is ready to use.
+type Counters struct {
+	mu       sync.RWMutex
+	counters map[string]*atomic.Int64
+}
+
+// Inc adds one to the named counter.
+func (c *Counters) Inc(name string) {
+	c.counter(name).Add(1)
+}
+
+// Add adds delta to the named counter.
+func (c *Counters) Add(name string, delta int64) {
+	c.counter(name).Add(delta)
+}
+
+// Get returns the value of the named counter, or zero if it does not exist.
+func (c *Counters) Get(name string) int64 {
+	c.mu.RLock()
+	ctr, ok := c.counters[name]
+	c.mu.RUnlock()
+
+	if !ok {
+		return 0
+	}
+	return ctr.Load()
+}
+
+// Snapshot returns the current value of every counter. Counters updated
+// while the snapshot is taken may or may not include those updates.
+func (c *Counters) Snapshot() map[string]int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	out := make(map[string]int64, len(c.counters))
+	for name, ctr := range c.counters {
+		out[name] = ctr.Load()
+	}
+	return out
+}
+
+// counter returns the named counter, creating it if needed. The fast path
+// takes only the read lock; creation re-checks under the write lock so that
+// racing callers share a single counter.
+func (c *Counters) counter(name string) *atomic.Int64 {
+	c.mu.RLock()
+	ctr, ok := c.counters[name]
+	c.mu.RUnlock()
+	if ok {
+		return ctr
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if ctr, ok := c.counters[name]; ok {
+		return ctr
+	}
+	if c.counters == nil {
+		c.counters = make(map[string]*atomic.Int64)
+	}
+	ctr = new(atomic.Int64)
+	c.counters[name] = ctr
+	return ctr
+}
@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"maps"
+	"sync"
+	"testing"
+)
+
+func TestCountersConcurrentNewName(t *testing.T) {
+	var c Counters
+
+	const goroutines, perGoroutine = 64, 100
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			for i := 0; i < perGoroutine; i++ {
+				c.Inc("requests")
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if got := c.Get("requests"); got != goroutines*perGoroutine {
+		t.Fatalf("Get = %d, want %d", got, goroutines*perGoroutine)
+	}
+	if n := len(c.Snapshot()); n != 1 {
+		t.Fatalf("Snapshot has %d counters, want 1", n)
+	}
+}
+
+func TestCountersAddAndSnapshot(t *testing.T) {
+	var c Counters
+	c.Inc("a")
+	c.Add("a", 4)
+	c.Add("b", -2)
+
+	if got := c.Get("missing"); got != 0 {
+		t.Fatalf("Get(missing) = %d, want 0", got)
+	}
+
+	want := map[string]int64{"a": 5, "b": -2}
+	if got := c.Snapshot(); !maps.Equal(got, want) {
+		t.Fatalf("Snapshot = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+// Package metrics provides lightweight in-process counters.
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+type bucket struct {
+	slot  int64 // index of the time slice the count belongs to
+	count int64
+}
+
+// SlidingWindow counts events over a rolling time window. The window is
+// split into equally sized buckets; a bucket's events drop out of the count
+// once it is older than the window, so counts expire with a granularity of
+// one bucket. It is safe for concurrent use.
+type SlidingWindow struct {
+	width time.Duration // span of a single bucket
+
+	mu      sync.Mutex
+	buckets []bucket
+}
+
+// NewSlidingWindow returns a counter over window split into the given
+// number of buckets. A non-positive bucket count is treated as one.
+func NewSlidingWindow(window time.Duration, buckets int) *SlidingWindow {
+	if buckets < 1 {
+		buckets = 1
+	}
+	width := window / time.Duration(buckets)
+	if width <= 0 {
+		width = 1
+	}
+
+	return &SlidingWindow{
+		width:   width,
+		buckets: make([]bucket, buckets),
+	}
+}
+
+// Incr records one event at the current time.
+func (w *SlidingWindow) Incr() {
+	slot := w.slot(time.Now())
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	b := &w.buckets[slot%int64(len(w.buckets))]
+	if b.slot != slot {
+		b.slot, b.count = slot, 0
+	}
+	b.count++
+}
+
+// Count returns the number of events recorded within the window.
+func (w *SlidingWindow) Count() int64 {
+	slot := w.slot(time.Now())
+	oldest := slot - int64(len(w.buckets)) + 1
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var total int64
+	for _, b := range w.buckets {
+		if b.slot >= oldest && b.slot <= slot {
+			total += b.count
+		}
+	}
+	return total
+}
+
+func (w *SlidingWindow) slot(t time.Time) int64 {
+	return t.UnixNano() / int64(w.width)
+}
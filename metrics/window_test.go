@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSlidingWindowExpires(t *testing.T) {
+	w := NewSlidingWindow(100*time.Millisecond, 10)
+
+	for i := 0; i < 5; i++ {
+		w.Incr()
+	}
+	if n := w.Count(); n != 5 {
+		t.Fatalf("Count = %d, want 5", n)
+	}
+
+	time.Sleep(150 * time.Millisecond)
+	if n := w.Count(); n != 0 {
+		t.Fatalf("Count after window = %d, want 0", n)
+	}
+
+	w.Incr()
+	if n := w.Count(); n != 1 {
+		t.Fatalf("Count after reuse = %d, want 1", n)
+	}
+}
+
+func TestSlidingWindowConcurrentIncr(t *testing.T) {
+	w := NewSlidingWindow(time.Minute, 6)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				w.Incr()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := w.Count(); n != 16*500 {
+		t.Fatalf("Count = %d, want %d", n, 16*500)
+	}
+}
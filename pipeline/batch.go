@@ -0,0 +1,57 @@
+package pipeline
+
+import "time"
+
+// Batch groups values from in into slices of up to maxSize elements. A
+// batch is emitted as soon as it is full, or once maxWait has elapsed since
+// its first element arrived, whichever comes first. When in is closed, any
+// partial batch is emitted before the returned channel is closed. A
+// non-positive maxSize is treated as one.
+func Batch[T any](in <-chan T, maxSize int, maxWait time.Duration) <-chan []T {
+	if maxSize < 1 {
+		maxSize = 1
+	}
+	out := make(chan []T)
+
+	go func() {
+		defer close(out)
+
+		var (
+			batch   []T
+			timer   *time.Timer
+			timeout <-chan time.Time // nil while the batch is empty
+		)
+		flush := func() {
+			if timer != nil {
+				timer.Stop()
+			}
+			timeout = nil
+			out <- batch
+			batch = nil
+		}
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if len(batch) > 0 {
+						flush()
+					}
+					return
+				}
+				if len(batch) == 0 {
+					timer = time.NewTimer(maxWait)
+					timeout = timer.C
+				}
+				batch = append(batch, v)
+				if len(batch) >= maxSize {
+					flush()
+				}
+			case <-timeout:
+				flush()
+			}
+		}
+	}()
+
+	return out
+}
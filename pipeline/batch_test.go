@@ -0,0 +1,50 @@
+package pipeline
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBatchSizeFlush(t *testing.T) {
+	// A fast producer fills batches long before maxWait.
+	var got [][]int
+	for b := range Batch(generate(7), 3, time.Hour) {
+		got = append(got, b)
+	}
+
+	want := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
+	if !slices.EqualFunc(got, want, slices.Equal[[]int]) {
+		t.Fatalf("Batch = %v, want %v", got, want)
+	}
+}
+
+func TestBatchTimeFlush(t *testing.T) {
+	// A slow producer never fills a batch, so each is flushed by maxWait.
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for i := 1; i <= 3; i++ {
+			in <- i
+			time.Sleep(60 * time.Millisecond)
+		}
+	}()
+
+	var got [][]int
+	for b := range Batch(in, 10, 20*time.Millisecond) {
+		got = append(got, b)
+	}
+
+	want := [][]int{{1}, {2}, {3}}
+	if !slices.EqualFunc(got, want, slices.Equal[[]int]) {
+		t.Fatalf("Batch = %v, want %v", got, want)
+	}
+}
+
+func TestBatchEmpty(t *testing.T) {
+	in := make(chan int)
+	close(in)
+	for b := range Batch(in, 3, time.Millisecond) {
+		t.Fatalf("Batch emitted %v from an empty input", b)
+	}
+}
@@ -0,0 +1,26 @@
+package pipeline
+
+// ProcessWithDLQ calls process on every value received from in, one at a
+// time and in order. Values for which process returns an error are
+// forwarded to the dead-letter channel dlq, in the order they failed.
+//
+// Both returned channels are closed once in has been drained; done carries
+// no values and only signals completion. dlq is unbuffered, so the caller
+// must keep receiving from it until it is closed or processing stalls.
+func ProcessWithDLQ[T any](in <-chan T, process func(T) error) (done <-chan struct{}, dlq <-chan T) {
+	doneCh := make(chan struct{})
+	dlqCh := make(chan T)
+
+	go func() {
+		defer close(doneCh)
+		defer close(dlqCh)
+
+		for v := range in {
+			if err := process(v); err != nil {
+				dlqCh <- v
+			}
+		}
+	}()
+
+	return doneCh, dlqCh
+}
@@ -0,0 +1,42 @@
+package pipeline
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestProcessWithDLQ(t *testing.T) {
+	var processed []int
+	done, dlq := ProcessWithDLQ(generate(10), func(n int) error {
+		processed = append(processed, n)
+		if n%2 == 0 {
+			return errors.New("even")
+		}
+		return nil
+	})
+
+	var failed []int
+	for v := range dlq {
+		failed = append(failed, v)
+	}
+	<-done
+
+	if want := []int{2, 4, 6, 8, 10}; !slices.Equal(failed, want) {
+		t.Fatalf("dead letters = %v, want %v", failed, want)
+	}
+	if len(processed) != 10 {
+		t.Fatalf("processed %d items, want 10", len(processed))
+	}
+}
+
+func TestProcessWithDLQNoFailures(t *testing.T) {
+	done, dlq := ProcessWithDLQ(generate(3), func(int) error { return nil })
+
+	if _, ok := <-dlq; ok {
+		t.Fatal("dead-letter channel received a value")
+	}
+	if _, ok := <-done; ok {
+		t.Fatal("done carried a value")
+	}
+}
@@ -0,0 +1,37 @@
+package pipeline
+
+import "sync/atomic"
+
+// FanOutDrop copies every value from in to each channel in outs without
+// ever blocking: if an output is not ready to receive, the value is dropped
+// for that output and counted. A slow or stalled consumer therefore loses
+// values instead of holding up the others; buffer the outputs to absorb
+// short bursts.
+//
+// FanOutDrop takes ownership of outs and closes each of them once in is
+// closed, so no channel may appear in outs twice. The returned function
+// reports the total number of drops so far and is safe to call from any
+// goroutine.
+func FanOutDrop[T any](in <-chan T, outs []chan<- T) (dropped func() int64) {
+	var drops atomic.Int64
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		for v := range in {
+			for _, out := range outs {
+				select {
+				case out <- v:
+				default:
+					drops.Add(1)
+				}
+			}
+		}
+	}()
+
+	return drops.Load
+}
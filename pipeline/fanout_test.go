@@ -0,0 +1,53 @@
+package pipeline
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFanOutDrop(t *testing.T) {
+	const n = 10
+	fast := make(chan int, n) // room for every value, so nothing is dropped
+	stalled := make(chan int) // never read, so every value is dropped
+
+	dropped := FanOutDrop(generate(n), []chan<- int{fast, stalled})
+
+	var got []int
+	for v := range fast {
+		got = append(got, v)
+	}
+	if want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}; !slices.Equal(got, want) {
+		t.Fatalf("fast consumer got %v, want %v", got, want)
+	}
+
+	// fast is closed only after in is drained, so the count is final.
+	if d := dropped(); d != n {
+		t.Fatalf("dropped = %d, want %d", d, n)
+	}
+	if _, ok := <-stalled; ok {
+		t.Fatal("stalled output not closed")
+	}
+}
+
+func TestFanOutDropGrows(t *testing.T) {
+	in := make(chan int)
+	stalled := make(chan int)
+	dropped := FanOutDrop(in, []chan<- int{stalled})
+
+	var last int64
+	for i := range 5 {
+		in <- i
+		// The send above is only accepted once the previous value has been
+		// fanned out, so by now at least i values have been dropped.
+		d := dropped()
+		if d < last || d < int64(i) {
+			t.Fatalf("dropped = %d after %d sends (previously %d)", d, i+1, last)
+		}
+		last = d
+	}
+	close(in)
+	<-stalled // wait for close
+	if d := dropped(); d != 5 {
+		t.Fatalf("dropped = %d, want 5", d)
+	}
+}
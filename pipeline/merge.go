@@ -0,0 +1,30 @@
+// Package pipeline provides small building blocks for composing
+// channel-based concurrent pipelines.
+package pipeline
+
+import "sync"
+
+// Merge fans in any number of input channels into a single output channel.
+// Each input is drained by its own goroutine, and the output is closed once
+// every input has been closed and fully forwarded.
+func Merge(chans ...<-chan string) <-chan string {
+	out := make(chan string)
+
+	var wg sync.WaitGroup
+	wg.Add(len(chans))
+	for _, ch := range chans {
+		go func(ch <-chan string) {
+			defer wg.Done()
+			for v := range ch {
+				out <- v
+			}
+		}(ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
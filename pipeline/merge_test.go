@@ -0,0 +1,54 @@
+package pipeline
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+func producer(name string, n int, delay time.Duration) <-chan string {
+	ch := make(chan string)
+	go func() {
+		defer close(ch)
+		for i := 0; i < n; i++ {
+			time.Sleep(delay)
+			ch <- fmt.Sprintf("%s%d", name, i)
+		}
+	}()
+	return ch
+}
+
+func TestMerge(t *testing.T) {
+	out := Merge(
+		producer("a", 3, 1*time.Millisecond),
+		producer("b", 3, 3*time.Millisecond),
+		producer("c", 3, 5*time.Millisecond),
+	)
+
+	var got []string
+	for v := range out {
+		got = append(got, v)
+	}
+	slices.Sort(got)
+
+	want := []string{"a0", "a1", "a2", "b0", "b1", "b2", "c0", "c1", "c2"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Merge = %v, want %v", got, want)
+	}
+
+	if _, ok := <-out; ok {
+		t.Fatal("output not closed after all inputs drained")
+	}
+}
+
+func TestMergeNoInputs(t *testing.T) {
+	select {
+	case _, ok := <-Merge():
+		if ok {
+			t.Fatal("Merge() emitted a value")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Merge() output not closed")
+	}
+}
@@ -0,0 +1,54 @@
+package pipeline
+
+import "context"
+
+// Run chains stages into a pipeline reading from src and returns its output.
+// Each stage runs in its own goroutine, in order. With no stages the values
+// from src are forwarded unchanged.
+//
+// When ctx is cancelled every stage goroutine returns promptly, even if the
+// consumer has stopped reading, and the output is closed; values in flight
+// are discarded. The output is also closed once src is closed and drained.
+// Run does not own src: stopping whatever goroutine feeds it is up to the
+// caller.
+func Run(ctx context.Context, src <-chan int, stages ...func(int) int) <-chan int {
+	if len(stages) == 0 {
+		return runStage(ctx, src, func(v int) int { return v })
+	}
+
+	out := src
+	for _, fn := range stages {
+		out = runStage(ctx, out, fn)
+	}
+	return out
+}
+
+// runStage is Stage with cancellation: its goroutine exits when ctx is done
+// whether it is waiting to receive or to send.
+func runStage(ctx context.Context, in <-chan int, fn func(int) int) <-chan int {
+	out := make(chan int)
+
+	go func() {
+		defer close(out)
+		for {
+			var v int
+			select {
+			case x, ok := <-in:
+				if !ok {
+					return
+				}
+				v = x
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case out <- fn(v):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
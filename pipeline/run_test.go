@@ -0,0 +1,70 @@
+package pipeline
+
+import (
+	"context"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	out := Run(context.Background(), generate(4),
+		func(v int) int { return v * 10 },
+		func(v int) int { return v + 1 },
+	)
+
+	var got []int
+	for v := range out {
+		got = append(got, v)
+	}
+	if want := []int{11, 21, 31, 41}; !slices.Equal(got, want) {
+		t.Fatalf("Run = %v, want %v", got, want)
+	}
+}
+
+func TestRunNoStages(t *testing.T) {
+	var got []int
+	for v := range Run(context.Background(), generate(3)) {
+		got = append(got, v)
+	}
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Fatalf("Run = %v, want %v", got, want)
+	}
+}
+
+func TestRunCancelNoLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	src := make(chan int)
+	go func() {
+		defer close(src)
+		for i := 0; ; i++ {
+			select {
+			case src <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	double := func(v int) int { return v * 2 }
+	out := Run(ctx, src, double, double, double)
+	for range 3 {
+		<-out
+	}
+	// Stop reading with values in flight, then cancel.
+	cancel()
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("NumGoroutine = %d after cancel, want %d", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if _, ok := <-out; ok {
+		t.Fatal("output not closed after cancel")
+	}
+}
@@ -0,0 +1,42 @@
+package pipeline
+
+// splitBuffer is the capacity of each channel returned by Split.
+const splitBuffer = 16
+
+// Split fans values from in out across n channels in round-robin order.
+// Every output is closed once in is closed. A non-positive n is treated as
+// one.
+//
+// Distribution is strictly round-robin: each output is buffered to hold
+// splitBuffer values, and once the next output in turn is full Split blocks
+// on it. A consumer that stops reading therefore lets the others progress
+// only until its buffer fills, after which all outputs stall. Consumers
+// should drain their channel until it is closed.
+func Split[T any](in <-chan T, n int) []<-chan T {
+	if n < 1 {
+		n = 1
+	}
+
+	outs := make([]chan T, n)
+	result := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T, splitBuffer)
+		result[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		i := 0
+		for v := range in {
+			outs[i] <- v
+			i = (i + 1) % n
+		}
+	}()
+
+	return result
+}
@@ -0,0 +1,42 @@
+package pipeline
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSplitRoundRobin(t *testing.T) {
+	src := make(chan int)
+	go func() {
+		defer close(src)
+		for i := 0; i < 12; i++ {
+			src <- i
+		}
+	}()
+
+	outs := Split(src, 3)
+	if len(outs) != 3 {
+		t.Fatalf("Split returned %d channels, want 3", len(outs))
+	}
+
+	got := make([][]int, len(outs))
+	var wg sync.WaitGroup
+	for i, out := range outs {
+		wg.Add(1)
+		go func(i int, out <-chan int) {
+			defer wg.Done()
+			for v := range out {
+				got[i] = append(got[i], v)
+			}
+		}(i, out)
+	}
+	wg.Wait()
+
+	for i, vals := range got {
+		want := []int{i, i + 3, i + 6, i + 9}
+		if !slices.Equal(vals, want) {
+			t.Errorf("output %d = %v, want %v", i, vals, want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package pipeline
+
+// Stage applies fn to every value received from in and forwards the result
+// on the returned channel, which is closed once in is closed. Stages can be
+// chained, e.g. Stage(Stage(src, parse), validate).
+//
+// The stage goroutine exits as soon as in is closed and its last result has
+// been received, so the consumer must keep reading until the output closes.
+func Stage[In any, Out any](in <-chan In, fn func(In) Out) <-chan Out {
+	out := make(chan Out)
+
+	go func() {
+		defer close(out)
+		for v := range in {
+			out <- fn(v)
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,44 @@
+package pipeline
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func generate(n int) <-chan int {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 1; i <= n; i++ {
+			ch <- i
+		}
+	}()
+	return ch
+}
+
+func TestStageChain(t *testing.T) {
+	square := func(n int) int { return n * n }
+	exclaim := func(s string) string { return s + "!" }
+
+	out := Stage(Stage(Stage(generate(5), square), strconv.Itoa), exclaim)
+
+	var got []string
+	for v := range out {
+		got = append(got, v)
+	}
+
+	want := []string{"1!", "4!", "9!", "16!", "25!"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("chain = %v, want %v", got, want)
+	}
+}
+
+func TestStageEmptyInput(t *testing.T) {
+	in := make(chan int)
+	close(in)
+
+	if _, ok := <-Stage(in, strconv.Itoa); ok {
+		t.Fatal("Stage emitted a value for a closed empty input")
+	}
+}
@@ -0,0 +1,38 @@
+package pipeline
+
+import "sync"
+
+// MergeTagged is Merge for named sources: every value is emitted together
+// with the name of the source that produced it. Any string, including the
+// empty string, is a valid name. The output is closed once every source has
+// been closed and fully forwarded.
+func MergeTagged[T any](sources map[string]<-chan T) <-chan struct {
+	Source string
+	Value  T
+} {
+	out := make(chan struct {
+		Source string
+		Value  T
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(len(sources))
+	for name, ch := range sources {
+		go func(name string, ch <-chan T) {
+			defer wg.Done()
+			for v := range ch {
+				out <- struct {
+					Source string
+					Value  T
+				}{Source: name, Value: v}
+			}
+		}(name, ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
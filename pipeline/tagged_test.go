@@ -0,0 +1,35 @@
+package pipeline
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMergeTagged(t *testing.T) {
+	sources := map[string]<-chan int{
+		"":      generate(3),
+		"other": generate(2),
+	}
+
+	got := make(map[string][]int)
+	for v := range MergeTagged(sources) {
+		got[v.Source] = append(got[v.Source], v.Value)
+	}
+
+	want := map[string][]int{"": {1, 2, 3}, "other": {1, 2}}
+	if len(got) != len(want) {
+		t.Fatalf("sources seen = %v, want %v", got, want)
+	}
+	for name, vals := range want {
+		// Each source is forwarded by one goroutine, so its order holds.
+		if !slices.Equal(got[name], vals) {
+			t.Errorf("values tagged %q = %v, want %v", name, got[name], vals)
+		}
+	}
+}
+
+func TestMergeTaggedNoSources(t *testing.T) {
+	for v := range MergeTagged[int](nil) {
+		t.Fatalf("MergeTagged(nil) emitted %v", v)
+	}
+}
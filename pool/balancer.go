@@ -0,0 +1,137 @@
+package pool
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// balancerQueue is the capacity of each worker's job channel.
+const balancerQueue = 16
+
+type balancedWorker struct {
+	weight  int
+	current int // smooth round-robin credit; guarded by Balancer.mu
+
+	jobs       chan func()
+	dispatched atomic.Int64
+	inFlight   atomic.Int64
+}
+
+// WorkerStats describes one worker of a Balancer.
+type WorkerStats struct {
+	Weight     int
+	Dispatched int64 // jobs routed to the worker so far
+	InFlight   int64 // jobs queued for or running on the worker
+}
+
+// Balancer routes jobs to a fixed set of worker goroutines in proportion to
+// their weights, using smooth weighted round-robin: over any run of jobs,
+// each worker's share matches its weight as closely as possible and heavy
+// workers are interleaved with light ones rather than served in bursts.
+// Workers with weight zero never receive jobs.
+type Balancer struct {
+	workers []*balancedWorker
+	total   int
+
+	mu     sync.Mutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewBalancer starts one worker goroutine per weight. It panics if any
+// weight is negative or if no weight is positive.
+func NewBalancer(weights ...int) *Balancer {
+	b := &Balancer{}
+	for _, w := range weights {
+		if w < 0 {
+			panic("pool: balancer weights must not be negative")
+		}
+		b.total += w
+		b.workers = append(b.workers, &balancedWorker{
+			weight: w,
+			jobs:   make(chan func(), balancerQueue),
+		})
+	}
+	if b.total == 0 {
+		panic("pool: balancer needs at least one worker with positive weight")
+	}
+
+	b.wg.Add(len(b.workers))
+	for _, w := range b.workers {
+		go b.work(w)
+	}
+
+	return b
+}
+
+func (b *Balancer) work(w *balancedWorker) {
+	defer b.wg.Done()
+	for job := range w.jobs {
+		job()
+		w.inFlight.Add(-1)
+	}
+}
+
+// Dispatch routes job to the next worker in weighted order, blocking while
+// that worker's queue is full. Jobs dispatched after Close are dropped.
+func (b *Balancer) Dispatch(job func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	w := b.next()
+	w.dispatched.Add(1)
+	w.inFlight.Add(1)
+	w.jobs <- job
+}
+
+// next picks a worker by smooth weighted round-robin. It must be called with
+// b.mu held.
+func (b *Balancer) next() *balancedWorker {
+	var best *balancedWorker
+	for _, w := range b.workers {
+		if w.weight == 0 {
+			continue
+		}
+		w.current += w.weight
+		if best == nil || w.current > best.current {
+			best = w
+		}
+	}
+	best.current -= b.total
+	return best
+}
+
+// Stats returns a snapshot of every worker's weight and job counts, in the
+// order the weights were given.
+func (b *Balancer) Stats() []WorkerStats {
+	out := make([]WorkerStats, len(b.workers))
+	for i, w := range b.workers {
+		out[i] = WorkerStats{
+			Weight:     w.weight,
+			Dispatched: w.dispatched.Load(),
+			InFlight:   w.inFlight.Load(),
+		}
+	}
+	return out
+}
+
+// Close stops accepting jobs, waits for every dispatched job to finish and
+// stops the workers. It is safe to call Close more than once.
+func (b *Balancer) Close() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	for _, w := range b.workers {
+		close(w.jobs)
+	}
+	b.mu.Unlock()
+
+	b.wg.Wait()
+}
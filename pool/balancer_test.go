@@ -0,0 +1,61 @@
+package pool
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestBalancerWeights(t *testing.T) {
+	b := NewBalancer(1, 2, 0, 3)
+
+	const jobs = 100
+	var ran atomic.Int64
+	for range jobs {
+		b.Dispatch(func() { ran.Add(1) })
+	}
+	b.Close()
+
+	if got := ran.Load(); got != jobs {
+		t.Fatalf("ran %d jobs, want %d", got, jobs)
+	}
+
+	// Smooth weighted round-robin keeps each share within one job of
+	// jobs*weight/total.
+	const total = 6
+	for i, s := range b.Stats() {
+		want := float64(jobs*s.Weight) / total
+		if d := float64(s.Dispatched) - want; d < -1 || d > 1 {
+			t.Errorf("worker %d (weight %d) got %d jobs, want %.1f±1", i, s.Weight, s.Dispatched, want)
+		}
+		if s.InFlight != 0 {
+			t.Errorf("worker %d InFlight = %d after Close, want 0", i, s.InFlight)
+		}
+	}
+	if s := b.Stats()[2]; s.Dispatched != 0 {
+		t.Fatalf("weight-0 worker got %d jobs, want 0", s.Dispatched)
+	}
+}
+
+func TestBalancerDispatchAfterClose(t *testing.T) {
+	b := NewBalancer(1)
+	b.Close()
+	b.Close() // idempotent
+
+	b.Dispatch(func() { t.Error("job ran after Close") })
+	if s := b.Stats()[0]; s.Dispatched != 0 {
+		t.Fatalf("Dispatched = %d after Close, want 0", s.Dispatched)
+	}
+}
+
+func TestNewBalancerPanics(t *testing.T) {
+	for _, weights := range [][]int{{}, {0, 0}, {1, -1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewBalancer(%v) did not panic", weights)
+				}
+			}()
+			NewBalancer(weights...)
+		}()
+	}
+}
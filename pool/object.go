@@ -0,0 +1,40 @@
+package pool
+
+import "sync"
+
+// Pool is a typed wrapper around sync.Pool for reusing temporary objects.
+// Like sync.Pool it is safe for concurrent use, and pooled objects may be
+// dropped at any time. T is normally a pointer type; pooling non-pointer
+// values allocates on every Put.
+type Pool[T any] struct {
+	reset func(T)
+	p     sync.Pool
+}
+
+// NewPool returns a pool that calls newFn to create an object when none is
+// available. If reset is non-nil, it is called on every object passed to
+// Put, before the object is returned to the pool. NewPool panics if newFn is
+// nil.
+func NewPool[T any](newFn func() T, reset func(T)) *Pool[T] {
+	if newFn == nil {
+		panic("pool: NewPool requires a non-nil constructor")
+	}
+
+	p := &Pool[T]{reset: reset}
+	p.p.New = func() any { return newFn() }
+	return p
+}
+
+// Get returns a pooled object, creating one with the constructor if the
+// pool is empty. Objects obtained from Put have already been reset.
+func (p *Pool[T]) Get() T {
+	return p.p.Get().(T)
+}
+
+// Put resets v and returns it to the pool. v must not be used afterwards.
+func (p *Pool[T]) Put(v T) {
+	if p.reset != nil {
+		p.reset(v)
+	}
+	p.p.Put(v)
+}
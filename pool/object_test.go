@@ -0,0 +1,66 @@
+package pool
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPoolReset(t *testing.T) {
+	var resets int
+	p := NewPool(
+		func() *bytes.Buffer { return new(bytes.Buffer) },
+		func(b *bytes.Buffer) { resets++; b.Reset() },
+	)
+
+	b := p.Get()
+	if resets != 0 {
+		t.Fatalf("resets after Get = %d, want 0", resets)
+	}
+	b.WriteString("dirty")
+	p.Put(b)
+	if resets != 1 {
+		t.Fatalf("resets after Put = %d, want 1", resets)
+	}
+	if b.Len() != 0 {
+		t.Fatalf("Len after Put = %d, want 0", b.Len())
+	}
+
+	p.Get()
+	if resets != 1 {
+		t.Fatalf("resets after second Get = %d, want 1", resets)
+	}
+}
+
+func TestNewPoolNilConstructor(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("NewPool(nil, nil) did not panic")
+		}
+	}()
+	NewPool[*bytes.Buffer](nil, nil)
+}
+
+var sink *bytes.Buffer
+
+func BenchmarkPool(b *testing.B) {
+	p := NewPool(
+		func() *bytes.Buffer { return bytes.NewBuffer(make([]byte, 0, 1024)) },
+		(*bytes.Buffer).Reset,
+	)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf := p.Get()
+		buf.WriteString("hello, pool")
+		sink = buf
+		p.Put(buf)
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf := bytes.NewBuffer(make([]byte, 0, 1024))
+		buf.WriteString("hello, pool")
+		sink = buf
+	}
+}
@@ -0,0 +1,88 @@
+// Package pool provides reusable goroutine and object pools.
+package pool
+
+import "sync"
+
+// WorkerPool runs a job function over submitted jobs using a fixed number of
+// worker goroutines. Results are delivered on the channel returned by
+// Results, which must be drained by the caller for workers to make progress.
+type WorkerPool[T any, R any] struct {
+	fn      func(T) R
+	jobs    chan T
+	results chan R
+
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewWorkerPool starts workers goroutines that apply fn to every submitted
+// job. A non-positive workers count is treated as one.
+func NewWorkerPool[T any, R any](workers int, fn func(T) R) *WorkerPool[T, R] {
+	if workers < 1 {
+		workers = 1
+	}
+
+	p := &WorkerPool[T, R]{
+		fn:      fn,
+		jobs:    make(chan T),
+		results: make(chan R),
+	}
+
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+
+	return p
+}
+
+func (p *WorkerPool[T, R]) work() {
+	defer p.wg.Done()
+	for job := range p.jobs {
+		p.results <- p.fn(job)
+	}
+}
+
+// Submit hands a job to the next free worker, blocking until one accepts it.
+// Jobs submitted after Close are dropped.
+//
+// Results is unbuffered, so a worker cannot take a new job until its last
+// result has been received. Once every worker holds an unreceived result,
+// Submit blocks. Callers submitting more jobs than there are workers must
+// receive from Results concurrently, for example in another goroutine.
+func (p *WorkerPool[T, R]) Submit(job T) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return
+	}
+	p.jobs <- job
+}
+
+// Results returns the channel on which job results are delivered. Close
+// closes it before returning.
+func (p *WorkerPool[T, R]) Results() <-chan R {
+	return p.results
+}
+
+// Close stops accepting jobs, waits for in-flight jobs to finish and closes
+// the results channel. Because in-flight jobs finish only once their
+// results are received, Close blocks until Results has been drained; call it
+// from a different goroutine than the one ranging over Results. It is safe
+// to call Close more than once, and to call it when no jobs were ever
+// submitted.
+func (p *WorkerPool[T, R]) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.jobs)
+	p.mu.Unlock()
+
+	p.wg.Wait()
+	close(p.results)
+}
@@ -0,0 +1,60 @@
+package pool
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestWorkerPool(t *testing.T) {
+	p := NewWorkerPool(3, func(n int) int { return n * n })
+
+	go func() {
+		for i := 1; i <= 10; i++ {
+			p.Submit(i)
+		}
+		p.Close()
+	}()
+
+	var got []int
+	for r := range p.Results() {
+		got = append(got, r)
+	}
+	slices.Sort(got)
+
+	want := []int{1, 4, 9, 16, 25, 36, 49, 64, 81, 100}
+	if !slices.Equal(got, want) {
+		t.Fatalf("results = %v, want %v", got, want)
+	}
+}
+
+func TestWorkerPoolCloseWithoutJobs(t *testing.T) {
+	p := NewWorkerPool(4, func(n int) int { return n })
+
+	done := make(chan struct{})
+	go func() {
+		p.Close()
+		p.Close()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Close deadlocked with no jobs submitted")
+	}
+
+	if _, ok := <-p.Results(); ok {
+		t.Fatal("Results not closed")
+	}
+}
+
+func TestWorkerPoolSubmitAfterClose(t *testing.T) {
+	p := NewWorkerPool(1, func(n int) int { return n })
+	p.Close()
+	p.Submit(1) // must not panic or block
+
+	if _, ok := <-p.Results(); ok {
+		t.Fatal("job submitted after Close produced a result")
+	}
+}
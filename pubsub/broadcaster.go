@@ -0,0 +1,77 @@
+// Package pubsub provides in-process publish/subscribe over channels.
+package pubsub
+
+import "sync"
+
+// Broadcaster delivers every published value to all current subscribers.
+type Broadcaster[T any] struct {
+	buffer int
+
+	mu   sync.Mutex
+	subs map[<-chan T]chan T
+}
+
+// NewBroadcaster returns a broadcaster whose subscriber channels are
+// buffered to hold buffer values. A negative buffer is treated as zero.
+func NewBroadcaster[T any](buffer int) *Broadcaster[T] {
+	if buffer < 0 {
+		buffer = 0
+	}
+	return &Broadcaster[T]{
+		buffer: buffer,
+		subs:   make(map[<-chan T]chan T),
+	}
+}
+
+// Subscribe registers a new subscriber and returns its receive channel.
+func (b *Broadcaster[T]) Subscribe() <-chan T {
+	ch := make(chan T, b.buffer)
+
+	b.mu.Lock()
+	b.subs[ch] = ch
+	b.mu.Unlock()
+
+	return ch
+}
+
+// Unsubscribe removes the subscriber and closes its channel. Unknown
+// channels are ignored.
+func (b *Broadcaster[T]) Unsubscribe(ch <-chan T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if sub, ok := b.subs[ch]; ok {
+		delete(b.subs, ch)
+		close(sub)
+	}
+}
+
+// Publish delivers v to every subscriber without blocking. A subscriber
+// whose buffer is full misses v: the value is dropped for that subscriber
+// only, so one slow reader never stalls the others. Publish returns the
+// number of subscribers that received v.
+func (b *Broadcaster[T]) Publish(v T) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delivered := 0
+	for _, sub := range b.subs {
+		select {
+		case sub <- v:
+			delivered++
+		default:
+		}
+	}
+	return delivered
+}
+
+// Close unsubscribes and closes every subscriber channel.
+func (b *Broadcaster[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for key, sub := range b.subs {
+		delete(b.subs, key)
+		close(sub)
+	}
+}
@@ -0,0 +1,66 @@
+package pubsub
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBroadcasterSlowSubscriber(t *testing.T) {
+	b := NewBroadcaster[int](2)
+	fast1 := b.Subscribe()
+	fast2 := b.Subscribe()
+	_ = b.Subscribe() // never read
+
+	const n = 10
+	got := make(chan int, 2)
+	for _, ch := range []<-chan int{fast1, fast2} {
+		go func(ch <-chan int) {
+			count := 0
+			for range ch {
+				count++
+			}
+			got <- count
+		}(ch)
+	}
+
+	published := make(chan struct{})
+	go func() {
+		for i := 0; i < n; i++ {
+			b.Publish(i)
+			time.Sleep(time.Millisecond)
+		}
+		close(published)
+	}()
+
+	select {
+	case <-published:
+	case <-time.After(time.Second):
+		t.Fatal("Publish blocked on a subscriber that never reads")
+	}
+	b.Close()
+
+	for i := 0; i < 2; i++ {
+		if c := <-got; c != n {
+			t.Errorf("reading subscriber got %d values, want %d", c, n)
+		}
+	}
+}
+
+func TestBroadcasterUnsubscribe(t *testing.T) {
+	b := NewBroadcaster[string](1)
+	a := b.Subscribe()
+	c := b.Subscribe()
+
+	b.Unsubscribe(a)
+	b.Unsubscribe(a) // unknown channels are ignored
+
+	if _, ok := <-a; ok {
+		t.Fatal("unsubscribed channel not closed")
+	}
+	if n := b.Publish("x"); n != 1 {
+		t.Fatalf("Publish delivered to %d subscribers, want 1", n)
+	}
+	if v := <-c; v != "x" {
+		t.Fatalf("got %q, want %q", v, "x")
+	}
+}
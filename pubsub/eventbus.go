@@ -0,0 +1,85 @@
+package pubsub
+
+import "sync"
+
+// EventBus routes values to subscribers by topic. Topics are typed only at
+// the call site: Subscribe and Publish are generic functions over a bus,
+// since Go methods cannot declare their own type parameters.
+type EventBus struct {
+	buffer int
+
+	mu     sync.Mutex
+	nextID uint64
+	topics map[string]map[uint64]any // values are chan T for some T
+}
+
+// NewEventBus returns a bus whose subscriber channels are buffered to hold
+// buffer values. A negative buffer is treated as zero.
+func NewEventBus(buffer int) *EventBus {
+	if buffer < 0 {
+		buffer = 0
+	}
+	return &EventBus{
+		buffer: buffer,
+		topics: make(map[string]map[uint64]any),
+	}
+}
+
+// Subscribe registers a subscriber for values of type T on topic. It
+// returns the channel values arrive on and a function that unsubscribes and
+// closes that channel; calling the function more than once is safe.
+func Subscribe[T any](bus *EventBus, topic string) (<-chan T, func()) {
+	ch := make(chan T, bus.buffer)
+
+	bus.mu.Lock()
+	id := bus.nextID
+	bus.nextID++
+	subs, ok := bus.topics[topic]
+	if !ok {
+		subs = make(map[uint64]any)
+		bus.topics[topic] = subs
+	}
+	subs[id] = ch
+	bus.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			bus.mu.Lock()
+			defer bus.mu.Unlock()
+
+			subs := bus.topics[topic]
+			delete(subs, id)
+			if len(subs) == 0 {
+				delete(bus.topics, topic)
+			}
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+// Publish delivers v to every subscriber of topic that subscribed with type
+// T. Subscribers of the same topic with a different type are skipped
+// silently, so one topic name can safely carry several unrelated types. As
+// with Broadcaster, delivery never blocks: a subscriber whose buffer is full
+// misses v. Publish returns the number of subscribers that received v.
+func Publish[T any](bus *EventBus, topic string, v T) int {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	delivered := 0
+	for _, sub := range bus.topics[topic] {
+		ch, ok := sub.(chan T)
+		if !ok {
+			continue
+		}
+		select {
+		case ch <- v:
+			delivered++
+		default:
+		}
+	}
+	return delivered
+}
@@ -0,0 +1,70 @@
+package pubsub
+
+import "testing"
+
+func TestEventBusTopics(t *testing.T) {
+	bus := NewEventBus(4)
+	users, unsubUsers := Subscribe[string](bus, "users")
+	defer unsubUsers()
+	orders, unsubOrders := Subscribe[string](bus, "orders")
+	defer unsubOrders()
+
+	if n := Publish(bus, "users", "ann"); n != 1 {
+		t.Fatalf("Publish(users) delivered to %d, want 1", n)
+	}
+	if n := Publish(bus, "orders", "o-1"); n != 1 {
+		t.Fatalf("Publish(orders) delivered to %d, want 1", n)
+	}
+	if n := Publish(bus, "nobody", "x"); n != 0 {
+		t.Fatalf("Publish(nobody) delivered to %d, want 0", n)
+	}
+
+	if v := <-users; v != "ann" {
+		t.Fatalf("users got %q, want ann", v)
+	}
+	if v := <-orders; v != "o-1" {
+		t.Fatalf("orders got %q, want o-1", v)
+	}
+	if len(users) != 0 || len(orders) != 0 {
+		t.Fatal("a value crossed topics")
+	}
+}
+
+func TestEventBusTypeMismatch(t *testing.T) {
+	bus := NewEventBus(4)
+	ints, unsubInts := Subscribe[int](bus, "t")
+	defer unsubInts()
+	strs, unsubStrs := Subscribe[string](bus, "t")
+	defer unsubStrs()
+
+	if n := Publish(bus, "t", "hello"); n != 1 {
+		t.Fatalf("Publish(string) delivered to %d, want 1", n)
+	}
+	if n := Publish(bus, "t", 42); n != 1 {
+		t.Fatalf("Publish(int) delivered to %d, want 1", n)
+	}
+
+	if v := <-strs; v != "hello" {
+		t.Fatalf("string subscriber got %q, want hello", v)
+	}
+	if v := <-ints; v != 42 {
+		t.Fatalf("int subscriber got %d, want 42", v)
+	}
+	if len(ints) != 0 || len(strs) != 0 {
+		t.Fatal("a subscriber received a value of the wrong type")
+	}
+}
+
+func TestEventBusUnsubscribe(t *testing.T) {
+	bus := NewEventBus(1)
+	ch, unsub := Subscribe[int](bus, "t")
+	unsub()
+	unsub() // safe to repeat
+
+	if _, ok := <-ch; ok {
+		t.Fatal("channel still open after unsubscribe")
+	}
+	if n := Publish(bus, "t", 1); n != 0 {
+		t.Fatalf("Publish after unsubscribe delivered to %d, want 0", n)
+	}
+}
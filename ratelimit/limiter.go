@@ -0,0 +1,82 @@
+// Package ratelimit provides primitives for limiting how often work runs.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter allows at most n operations per interval. Tokens are kept in a
+// buffered channel of capacity n that a ticker refills once per interval, so
+// up to n operations may burst through at once.
+type RateLimiter struct {
+	tokens   chan struct{}
+	ticker   *time.Ticker
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewRateLimiter returns a limiter allowing n operations per interval. The
+// bucket starts full. Call Stop to release the background ticker.
+func NewRateLimiter(n int, interval time.Duration) *RateLimiter {
+	if n < 1 {
+		n = 1
+	}
+
+	l := &RateLimiter{
+		tokens: make(chan struct{}, n),
+		ticker: time.NewTicker(interval),
+		done:   make(chan struct{}),
+	}
+	l.refill()
+
+	go l.run()
+
+	return l
+}
+
+func (l *RateLimiter) run() {
+	for {
+		select {
+		case <-l.ticker.C:
+			l.refill()
+		case <-l.done:
+			return
+		}
+	}
+}
+
+// refill tops the bucket up to capacity without blocking. It sends at most
+// cap(tokens) tokens so that waiters draining the bucket concurrently cannot
+// receive more than one interval's worth.
+func (l *RateLimiter) refill() {
+	for i := 0; i < cap(l.tokens); i++ {
+		select {
+		case l.tokens <- struct{}{}:
+		default:
+			return
+		}
+	}
+}
+
+// Wait blocks until a token is available or ctx is done, in which case it
+// returns ctx.Err().
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	select {
+	case <-l.tokens:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stop halts the refill ticker. Tokens already in the bucket can still be
+// taken, after which Wait blocks until its context is done. It is safe to
+// call Stop more than once.
+func (l *RateLimiter) Stop() {
+	l.stopOnce.Do(func() {
+		l.ticker.Stop()
+		close(l.done)
+	})
+}
@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	l := NewRateLimiter(2, time.Second)
+	defer l.Stop()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait #%d: %v", i+1, err)
+		}
+	}
+
+	// Two tokens up front, two more after one second and the last after
+	// two seconds.
+	if elapsed := time.Since(start); elapsed < 1900*time.Millisecond {
+		t.Fatalf("5 waits at 2/s took %v, want at least ~2s", elapsed)
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	l := NewRateLimiter(1, time.Hour)
+	defer l.Stop()
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("first Wait: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimiterStopTwice(t *testing.T) {
+	l := NewRateLimiter(1, time.Second)
+	l.Stop()
+	l.Stop()
+}
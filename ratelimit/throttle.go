@@ -0,0 +1,32 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Throttle returns a function that calls fn at most once per d. The first
+// call runs fn immediately; further calls within d of it are dropped rather
+// than deferred, which is what distinguishes it from a trailing-edge
+// debounce. The returned function is safe for concurrent use, and fn runs on
+// the calling goroutine.
+func Throttle(fn func(), d time.Duration) func() {
+	var (
+		mu   sync.Mutex
+		last time.Time
+	)
+
+	return func() {
+		now := time.Now()
+
+		mu.Lock()
+		if !last.IsZero() && now.Sub(last) < d {
+			mu.Unlock()
+			return
+		}
+		last = now
+		mu.Unlock()
+
+		fn()
+	}
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThrottle(t *testing.T) {
+	var calls atomic.Int32
+	f := Throttle(func() { calls.Add(1) }, time.Hour)
+
+	for range 10 {
+		f()
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("calls = %d, want 1", got)
+	}
+}
+
+func TestThrottleConcurrent(t *testing.T) {
+	var calls atomic.Int32
+	f := Throttle(func() { calls.Add(1) }, time.Hour)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f()
+		}()
+	}
+	wg.Wait()
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("calls = %d, want 1", got)
+	}
+}
+
+func TestThrottleAfterInterval(t *testing.T) {
+	var calls atomic.Int32
+	f := Throttle(func() { calls.Add(1) }, 20*time.Millisecond)
+
+	f()
+	time.Sleep(40 * time.Millisecond)
+	f()
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("calls = %d, want 2", got)
+	}
+}
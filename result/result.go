@@ -0,0 +1,39 @@
+// Package result provides a value-or-error type, handy for carrying errors
+// alongside values on a single channel.
+package result
+
+// Result holds either a value or an error.
+type Result[T any] struct {
+	val T
+	err error
+}
+
+// Ok returns a successful result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{val: v}
+}
+
+// Err returns a failed result holding err.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// IsOk reports whether r holds a value rather than an error.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Unwrap returns the value and error held by r. For a failed result the
+// value is the zero value.
+func (r Result[T]) Unwrap() (T, error) {
+	return r.val, r.err
+}
+
+// Map applies fn to the value of a successful result. A failed result is
+// returned unchanged and fn is not called.
+func (r Result[T]) Map(fn func(T) T) Result[T] {
+	if r.err != nil {
+		return r
+	}
+	return Ok(fn(r.val))
+}
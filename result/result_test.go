@@ -0,0 +1,36 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOk(t *testing.T) {
+	r := Ok(21).Map(func(n int) int { return n * 2 })
+	if !r.IsOk() {
+		t.Fatal("Ok result reported as failed")
+	}
+	if v, err := r.Unwrap(); v != 42 || err != nil {
+		t.Fatalf("Unwrap = %d, %v; want 42, nil", v, err)
+	}
+}
+
+func TestMapShortCircuitsOnError(t *testing.T) {
+	boom := errors.New("boom")
+
+	called := false
+	r := Err[int](boom).Map(func(n int) int {
+		called = true
+		return n + 1
+	})
+
+	if called {
+		t.Fatal("Map called fn on an error result")
+	}
+	if r.IsOk() {
+		t.Fatal("error result reported as ok")
+	}
+	if v, err := r.Unwrap(); v != 0 || !errors.Is(err, boom) {
+		t.Fatalf("Unwrap = %d, %v; want 0, %v", v, err, boom)
+	}
+}
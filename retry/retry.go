@@ -0,0 +1,91 @@
+// Package retry retries failing operations with exponential backoff.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// ErrRetriesExhausted is wrapped by the error Retry returns when every
+// attempt failed.
+var ErrRetriesExhausted = errors.New("retry: attempts exhausted")
+
+const (
+	defaultBaseDelay = 100 * time.Millisecond
+	defaultMaxDelay  = 10 * time.Second
+)
+
+type config struct {
+	baseDelay time.Duration
+	maxDelay  time.Duration
+}
+
+// Option configures Retry.
+type Option func(*config)
+
+// WithBaseDelay sets the delay before the second attempt. Each following
+// delay doubles. The default is 100ms.
+func WithBaseDelay(d time.Duration) Option {
+	return func(c *config) { c.baseDelay = d }
+}
+
+// WithMaxDelay caps the delay between attempts. The default is 10s.
+func WithMaxDelay(d time.Duration) Option {
+	return func(c *config) { c.maxDelay = d }
+}
+
+// Retry calls fn until it returns nil, up to attempts times. Between
+// attempts it sleeps for an exponentially growing delay, randomised to
+// between half and all of the nominal value so concurrent callers spread
+// out.
+//
+// If every attempt fails, the returned error wraps both ErrRetriesExhausted
+// and the last error from fn. If ctx is done while waiting, Retry stops
+// early and returns an error wrapping ctx.Err() and the last error.
+func Retry(ctx context.Context, attempts int, fn func() error, opts ...Option) error {
+	cfg := config{baseDelay: defaultBaseDelay, maxDelay: defaultMaxDelay}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	delay := cfg.baseDelay
+	for i := 0; i < attempts; i++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if i == attempts-1 {
+			break
+		}
+
+		timer := time.NewTimer(jitter(delay))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w (last error: %w)", ctx.Err(), err)
+		}
+
+		delay *= 2
+		if delay > cfg.maxDelay {
+			delay = cfg.maxDelay
+		}
+	}
+
+	return fmt.Errorf("%w after %d attempts: %w", ErrRetriesExhausted, attempts, err)
+}
+
+// jitter returns a random duration in [d/2, d].
+func jitter(d time.Duration) time.Duration {
+	half := d / 2
+	if half <= 0 {
+		return d
+	}
+	return half + rand.N(half+1)
+}
@@ -0,0 +1,82 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetrySucceedsOnSecondAttempt(t *testing.T) {
+	calls := 0
+	start := time.Now()
+
+	err := Retry(context.Background(), 5, func() error {
+		calls++
+		if calls < 2 {
+			return errors.New("transient")
+		}
+		return nil
+	}, WithBaseDelay(20*time.Millisecond))
+
+	if err != nil {
+		t.Fatalf("Retry = %v, want nil", err)
+	}
+	if calls != 2 {
+		t.Fatalf("fn called %d times, want 2", calls)
+	}
+	// One backoff of at most the base delay, and no sleep after success.
+	if elapsed := time.Since(start); elapsed > 40*time.Millisecond {
+		t.Fatalf("Retry took %v, want about one base delay", elapsed)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+
+	err := Retry(context.Background(), 3, func() error {
+		calls++
+		return boom
+	}, WithBaseDelay(time.Millisecond))
+
+	if !errors.Is(err, ErrRetriesExhausted) {
+		t.Fatalf("Retry = %v, want ErrRetriesExhausted", err)
+	}
+	if !errors.Is(err, boom) {
+		t.Fatalf("Retry = %v, want it to wrap the last error", err)
+	}
+	if calls != 3 {
+		t.Fatalf("fn called %d times, want 3", calls)
+	}
+}
+
+func TestRetryContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	err := Retry(ctx, 100, func() error {
+		calls++
+		return errors.New("fail")
+	}, WithBaseDelay(time.Hour))
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Retry = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if errors.Is(err, ErrRetriesExhausted) {
+		t.Fatal("aborted Retry reported exhaustion")
+	}
+	if calls != 1 {
+		t.Fatalf("fn called %d times, want 1", calls)
+	}
+}
+
+func TestJitterBounds(t *testing.T) {
+	const d = 100 * time.Millisecond
+	for i := 0; i < 1000; i++ {
+		if j := jitter(d); j < d/2 || j > d {
+			t.Fatalf("jitter(%v) = %v, want within [%v, %v]", d, j, d/2, d)
+		}
+	}
+}
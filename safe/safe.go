@@ -0,0 +1,90 @@
+// Package safe runs functions in goroutines, turning panics into errors.
+package safe
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// ErrGoexit is reported for a function that stopped by calling
+// runtime.Goexit instead of returning.
+var ErrGoexit = errors.New("safe: function called runtime.Goexit")
+
+// PanicError is the error produced when a function run by this package
+// panics.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v\n\n%s", e.Value, e.Stack)
+}
+
+// Unwrap returns the panic value if it is an error, so errors.Is and
+// errors.As see through a PanicError.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Go runs fn in a new goroutine and returns a channel that receives its
+// result once it finishes. A panic in fn is recovered and delivered as a
+// *PanicError, and a call to runtime.Goexit as ErrGoexit. The channel is
+// buffered, so the goroutine exits even if the result is never received.
+func Go(fn func() error) <-chan error {
+	ch := make(chan error, 1)
+	go run(fn, func(err error) { ch <- err })
+	return ch
+}
+
+// SafeGo runs fn in a new goroutine and waits for it, returning its error
+// or, if it panicked, a *PanicError.
+func SafeGo(fn func() error) error {
+	return <-Go(fn)
+}
+
+// SafeGoN runs every fn concurrently, waits for all of them and returns
+// their errors, including recovered panics, joined with errors.Join. It
+// returns nil if every fn succeeded.
+func SafeGoN(fns ...func() error) error {
+	errs := make([]error, len(fns))
+
+	var wg sync.WaitGroup
+	wg.Add(len(fns))
+	for i, fn := range fns {
+		go run(fn, func(err error) {
+			errs[i] = err
+			wg.Done()
+		})
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// run invokes fn and passes its outcome to done. done is called from a
+// deferred function so that it runs however fn ends: by returning, by
+// panicking, which yields a *PanicError, or by calling runtime.Goexit, which
+// yields ErrGoexit.
+func run(fn func() error, done func(error)) {
+	var (
+		err      error
+		returned bool
+	)
+	defer func() {
+		if v := recover(); v != nil {
+			err = &PanicError{Value: v, Stack: debug.Stack()}
+		} else if !returned {
+			err = ErrGoexit
+		}
+		done(err)
+	}()
+
+	err = fn()
+	returned = true
+}
@@ -0,0 +1,85 @@
+package safe
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSafeGoRecoversPanic(t *testing.T) {
+	err := SafeGo(func() error { panic("kaboom") })
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("SafeGo = %v, want *PanicError", err)
+	}
+	if !strings.Contains(err.Error(), "kaboom") {
+		t.Fatalf("error %q does not contain the panic message", err)
+	}
+	if len(pe.Stack) == 0 {
+		t.Fatal("PanicError has no stack trace")
+	}
+}
+
+func TestSafeGoReturnsError(t *testing.T) {
+	boom := errors.New("boom")
+	if err := SafeGo(func() error { return boom }); !errors.Is(err, boom) {
+		t.Fatalf("SafeGo = %v, want %v", err, boom)
+	}
+	if err := SafeGo(func() error { return nil }); err != nil {
+		t.Fatalf("SafeGo = %v, want nil", err)
+	}
+}
+
+func TestSafeGoPanicWithError(t *testing.T) {
+	boom := errors.New("boom")
+	if err := SafeGo(func() error { panic(boom) }); !errors.Is(err, boom) {
+		t.Fatalf("SafeGo = %v, want it to unwrap to %v", err, boom)
+	}
+}
+
+func TestSafeGoGoexit(t *testing.T) {
+	done := make(chan error)
+	go func() {
+		done <- SafeGo(func() error {
+			runtime.Goexit()
+			return nil
+		})
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrGoexit) {
+			t.Fatalf("SafeGo = %v, want ErrGoexit", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("SafeGo blocked after runtime.Goexit")
+	}
+}
+
+func TestSafeGoN(t *testing.T) {
+	boom := errors.New("boom")
+	err := SafeGoN(
+		func() error { return nil },
+		func() error { return boom },
+		func() error { panic("kaboom") },
+		func() error { runtime.Goexit(); return nil },
+	)
+
+	if !errors.Is(err, boom) {
+		t.Fatalf("SafeGoN = %v, want it to include %v", err, boom)
+	}
+	if !errors.Is(err, ErrGoexit) {
+		t.Fatalf("SafeGoN = %v, want it to include ErrGoexit", err)
+	}
+	var pe *PanicError
+	if !errors.As(err, &pe) || !strings.Contains(err.Error(), "kaboom") {
+		t.Fatalf("SafeGoN = %v, want it to include the panic", err)
+	}
+
+	if err := SafeGoN(); err != nil {
+		t.Fatalf("SafeGoN() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,96 @@
+// Package schedule runs recurring tasks on fixed intervals.
+package schedule
+
+import (
+	"sync"
+	"time"
+)
+
+type task struct {
+	interval time.Duration
+	fn       func()
+}
+
+// Scheduler runs registered tasks periodically. Every task runs in its own
+// goroutine, so a slow task delays only its own next run. The zero value is
+// ready to use.
+type Scheduler struct {
+	mu      sync.Mutex
+	tasks   []task
+	running bool
+	stop    chan struct{}
+	wg      *sync.WaitGroup // tracks the current run's task loops
+}
+
+// Every registers fn to run once every d after Start. Tasks registered
+// while the scheduler is running start immediately. It panics if d is not
+// positive.
+func (s *Scheduler) Every(d time.Duration, fn func()) {
+	if d <= 0 {
+		panic("schedule: interval must be positive")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := task{interval: d, fn: fn}
+	s.tasks = append(s.tasks, t)
+	if s.running {
+		s.launch(t)
+	}
+}
+
+// Start begins running every registered task. Calling Start on a running
+// scheduler is a no-op.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return
+	}
+	s.running = true
+	s.stop = make(chan struct{})
+	s.wg = new(sync.WaitGroup)
+	for _, t := range s.tasks {
+		s.launch(t)
+	}
+}
+
+// Stop halts all tasks and waits for any in-flight executions to return.
+// Calling Stop before Start or more than once is safe. A stopped scheduler
+// may be started again.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.running = false
+	close(s.stop)
+	wg := s.wg
+	s.mu.Unlock()
+
+	wg.Wait()
+}
+
+// launch starts t's ticker loop. It must be called with s.mu held.
+func (s *Scheduler) launch(t task) {
+	stop, wg := s.stop, s.wg
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				t.fn()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,85 @@
+package schedule
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSchedulerRunsPeriodically(t *testing.T) {
+	var s Scheduler
+	var runs atomic.Int32
+	s.Every(100*time.Millisecond, func() { runs.Add(1) })
+
+	s.Start()
+	time.Sleep(350 * time.Millisecond)
+	s.Stop()
+
+	if n := runs.Load(); n != 3 {
+		t.Fatalf("task ran %d times in 350ms at 100ms, want 3", n)
+	}
+
+	time.Sleep(150 * time.Millisecond)
+	if n := runs.Load(); n != 3 {
+		t.Fatalf("task ran after Stop: %d runs", n)
+	}
+}
+
+func TestSchedulerSlowTaskDoesNotDelayOthers(t *testing.T) {
+	var s Scheduler
+	var fast atomic.Int32
+	s.Every(10*time.Millisecond, func() { time.Sleep(200 * time.Millisecond) })
+	s.Every(10*time.Millisecond, func() { fast.Add(1) })
+
+	s.Start()
+	time.Sleep(100 * time.Millisecond)
+	s.Stop()
+
+	if n := fast.Load(); n < 5 {
+		t.Fatalf("fast task ran %d times, want it unaffected by the slow one", n)
+	}
+}
+
+func TestSchedulerStopWaitsForInFlight(t *testing.T) {
+	var s Scheduler
+	var finished atomic.Bool
+	s.Every(5*time.Millisecond, func() {
+		time.Sleep(50 * time.Millisecond)
+		finished.Store(true)
+	})
+
+	s.Start()
+	time.Sleep(20 * time.Millisecond)
+	s.Stop()
+
+	if !finished.Load() {
+		t.Fatal("Stop returned before the running task finished")
+	}
+}
+
+func TestSchedulerStopSafety(t *testing.T) {
+	var s Scheduler
+	s.Stop() // before Start
+
+	s.Every(time.Millisecond, func() {})
+	s.Start()
+	s.Start()
+	s.Stop()
+	s.Stop()
+}
+
+func TestSchedulerEveryRejectsNonPositiveInterval(t *testing.T) {
+	for _, d := range []time.Duration{0, -time.Second} {
+		func() {
+			var s Scheduler
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Every(%v) did not panic", d)
+				}
+				s.Stop()
+			}()
+			s.Start() // a running scheduler would launch the task at once
+			s.Every(d, func() {})
+		}()
+	}
+}
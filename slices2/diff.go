@@ -0,0 +1,112 @@
+package slices2
+
+// Diff compares two slices as multisets, ignoring order. added holds the
+// elements of new not matched by an element of old, and removed the
+// elements of old not matched in new, each in their original order. An
+// element repeated k times in one slice and j times in the other appears
+// |k-j| times in the corresponding result. Both results are non-nil.
+func Diff[T comparable](old, new []T) (added, removed []T) {
+	counts := make(map[T]int, len(old))
+	for _, v := range old {
+		counts[v]++
+	}
+
+	added = make([]T, 0)
+	for _, v := range new {
+		if counts[v] > 0 {
+			counts[v]--
+			continue
+		}
+		added = append(added, v)
+	}
+
+	removed = make([]T, 0)
+	for _, v := range old {
+		if counts[v] > 0 {
+			counts[v]--
+			removed = append(removed, v)
+		}
+	}
+
+	return added, removed
+}
+
+// EditOp is the kind of an Edit.
+type EditOp int
+
+const (
+	// Keep marks an element present in both slices.
+	Keep EditOp = iota
+	// Insert marks an element present only in the new slice.
+	Insert
+	// Delete marks an element present only in the old slice.
+	Delete
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case Keep:
+		return "keep"
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// Edit is one step of an edit script produced by DiffOrdered.
+type Edit[T any] struct {
+	Op    EditOp
+	Value T
+}
+
+// DiffOrdered returns a minimal edit script turning old into new, based on
+// their longest common subsequence. Replaying the script in order, keeping
+// Keep and Insert values, yields new; keeping Keep and Delete values yields
+// old. Unlike Diff it is order-sensitive, so a moved element shows up as a
+// Delete and an Insert. It runs in O(len(old)*len(new)) time and space.
+func DiffOrdered[T comparable](old, new []T) []Edit[T] {
+	n, m := len(old), len(new)
+
+	// lcs[i][j] is the LCS length of old[i:] and new[j:].
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if old[i] == new[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]Edit[T], 0, max(n, m))
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case old[i] == new[j]:
+			edits = append(edits, Edit[T]{Op: Keep, Value: old[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, Edit[T]{Op: Delete, Value: old[i]})
+			i++
+		default:
+			edits = append(edits, Edit[T]{Op: Insert, Value: new[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		edits = append(edits, Edit[T]{Op: Delete, Value: old[i]})
+	}
+	for ; j < m; j++ {
+		edits = append(edits, Edit[T]{Op: Insert, Value: new[j]})
+	}
+
+	return edits
+}
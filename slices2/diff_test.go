@@ -0,0 +1,89 @@
+package slices2
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name           string
+		old, new       []int
+		added, removed []int
+	}{
+		{"empty", nil, nil, []int{}, []int{}},
+		{"identical", []int{1, 2, 3}, []int{1, 2, 3}, []int{}, []int{}},
+		{"disjoint", []int{1, 2}, []int{3, 4}, []int{3, 4}, []int{1, 2}},
+		{"insertions", []int{1, 3}, []int{1, 2, 3, 4}, []int{2, 4}, []int{}},
+		{"deletions", []int{1, 2, 3, 4}, []int{2, 4}, []int{}, []int{1, 3}},
+		{"reordering", []int{1, 2, 3}, []int{3, 1, 2}, []int{}, []int{}},
+		{"duplicates", []int{1, 1, 2}, []int{1, 2, 2}, []int{2}, []int{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := Diff(tt.old, tt.new)
+			if added == nil || removed == nil {
+				t.Fatalf("Diff returned nil: added %v, removed %v", added, removed)
+			}
+			if !slices.Equal(added, tt.added) {
+				t.Errorf("added = %v, want %v", added, tt.added)
+			}
+			if !slices.Equal(removed, tt.removed) {
+				t.Errorf("removed = %v, want %v", removed, tt.removed)
+			}
+		})
+	}
+}
+
+// replay rebuilds one side of an edit script, keeping Keep values and those
+// with op.
+func replay(edits []Edit[int], op EditOp) []int {
+	out := []int{}
+	for _, e := range edits {
+		if e.Op == Keep || e.Op == op {
+			out = append(out, e.Value)
+		}
+	}
+	return out
+}
+
+func TestDiffOrdered(t *testing.T) {
+	k := func(v int) Edit[int] { return Edit[int]{Keep, v} }
+	ins := func(v int) Edit[int] { return Edit[int]{Insert, v} }
+	del := func(v int) Edit[int] { return Edit[int]{Delete, v} }
+
+	tests := []struct {
+		name     string
+		old, new []int
+		want     []Edit[int]
+	}{
+		{"empty", nil, nil, []Edit[int]{}},
+		{"identical", []int{1, 2, 3}, []int{1, 2, 3}, []Edit[int]{k(1), k(2), k(3)}},
+		{"disjoint", []int{1, 2}, []int{3, 4}, []Edit[int]{del(1), del(2), ins(3), ins(4)}},
+		{"insertions", []int{1, 3}, []int{1, 2, 3, 4}, []Edit[int]{k(1), ins(2), k(3), ins(4)}},
+		{"deletions", []int{1, 2, 3, 4}, []int{2, 4}, []Edit[int]{del(1), k(2), del(3), k(4)}},
+		{"reordering", []int{1, 2, 3}, []int{3, 1, 2}, []Edit[int]{ins(3), k(1), k(2), del(3)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffOrdered(tt.old, tt.new)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("DiffOrdered = %v, want %v", got, tt.want)
+			}
+			if old := replay(got, Delete); !slices.Equal(old, tt.old) {
+				t.Errorf("replaying deletes = %v, want %v", old, tt.old)
+			}
+			if new := replay(got, Insert); !slices.Equal(new, tt.new) {
+				t.Errorf("replaying inserts = %v, want %v", new, tt.new)
+			}
+		})
+	}
+}
+
+func TestEditOpString(t *testing.T) {
+	for op, want := range map[EditOp]string{Keep: "keep", Insert: "insert", Delete: "delete", 9: "unknown"} {
+		if got := op.String(); got != want {
+			t.Errorf("EditOp(%d).String() = %q, want %q", op, got, want)
+		}
+	}
+}
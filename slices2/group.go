@@ -0,0 +1,13 @@
+package slices2
+
+// GroupBy partitions s into groups keyed by key. Elements within a group
+// keep their relative order from s. The result is never nil, even for a nil
+// or empty s.
+func GroupBy[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, v := range s {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
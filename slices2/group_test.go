@@ -0,0 +1,39 @@
+package slices2
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	parity := func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	tests := []struct {
+		name string
+		in   []int
+		key  func(int) string
+		want map[string][]int
+	}{
+		{"nil", nil, parity, map[string][]int{}},
+		{"empty", []int{}, parity, map[string][]int{}},
+		{"single bucket", []int{5, 3, 9, 1}, func(int) string { return "all" }, map[string][]int{"all": {5, 3, 9, 1}}},
+		{"several buckets", []int{4, 1, 2, 7, 6}, parity, map[string][]int{"even": {4, 2, 6}, "odd": {1, 7}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GroupBy(tt.in, tt.key)
+			if got == nil {
+				t.Fatal("GroupBy returned a nil map")
+			}
+			if !maps.EqualFunc(got, tt.want, slices.Equal[[]int]) {
+				t.Fatalf("GroupBy = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Package slices2 provides generic functional helpers over slices that
+// complement the standard slices package.
+package slices2
+
+// Map returns a new slice holding fn applied to each element of s. The
+// result is never nil, even for a nil or empty s.
+func Map[T, R any](s []T, fn func(T) R) []R {
+	out := make([]R, len(s))
+	for i, v := range s {
+		out[i] = fn(v)
+	}
+	return out
+}
+
+// Filter returns a new slice holding the elements of s for which pred
+// returns true, in their original order. The result is never nil.
+func Filter[T any](s []T, pred func(T) bool) []T {
+	out := make([]T, 0, len(s))
+	for _, v := range s {
+		if pred(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Reduce folds s into a single value by calling fn on the accumulator and
+// each element in order, starting from init. It returns init for a nil or
+// empty s.
+func Reduce[T, R any](s []T, init R, fn func(R, T) R) R {
+	acc := init
+	for _, v := range s {
+		acc = fn(acc, v)
+	}
+	return acc
+}
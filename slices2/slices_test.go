@@ -0,0 +1,81 @@
+package slices2
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []string
+	}{
+		{"nil", nil, []string{}},
+		{"empty", []int{}, []string{}},
+		{"values", []int{1, 2, 3}, []string{"1", "2", "3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Map(tt.in, strconv.Itoa)
+			if got == nil || !slices.Equal(got, tt.want) {
+				t.Fatalf("Map(%v) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"nil", nil, []int{}},
+		{"none match", []int{1, 3}, []int{}},
+		{"some match", []int{1, 2, 3, 4}, []int{2, 4}},
+		{"all match", []int{2, 4}, []int{2, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Filter(tt.in, even)
+			if got == nil || !slices.Equal(got, tt.want) {
+				t.Fatalf("Filter(%v) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(acc, n int) int { return acc + n }
+	tests := []struct {
+		name string
+		in   []int
+		init int
+		want int
+	}{
+		{"nil returns init", nil, 10, 10},
+		{"empty returns init", []int{}, 7, 7},
+		{"sum", []int{1, 2, 3, 4}, 0, 10},
+		{"sum with init", []int{1, 2}, 100, 103},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reduce(tt.in, tt.init, sum); got != tt.want {
+				t.Fatalf("Reduce(%v, %d) = %d, want %d", tt.in, tt.init, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterThenMap(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6}
+	got := Map(Filter(in, func(n int) bool { return n%2 == 0 }), func(n int) string {
+		return strconv.Itoa(n * 10)
+	})
+	if want := []string{"20", "40", "60"}; !slices.Equal(got, want) {
+		t.Fatalf("Filter then Map = %v, want %v", got, want)
+	}
+}
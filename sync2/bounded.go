@@ -0,0 +1,52 @@
+package sync2
+
+import "context"
+
+// BoundedBuffer is a fixed-capacity FIFO buffer for producer/consumer
+// handoff. Put blocks while the buffer is full and Get blocks while it is
+// empty; both give up when their context is done.
+type BoundedBuffer[T any] struct {
+	items chan T
+}
+
+// NewBoundedBuffer returns an empty buffer holding at most capacity values.
+// It panics if capacity is not positive.
+func NewBoundedBuffer[T any](capacity int) *BoundedBuffer[T] {
+	if capacity < 1 {
+		panic("sync2: bounded buffer capacity must be positive")
+	}
+	return &BoundedBuffer[T]{items: make(chan T, capacity)}
+}
+
+// Put appends v, blocking until there is room or ctx is done, in which case
+// it returns ctx.Err() and v is not stored.
+func (b *BoundedBuffer[T]) Put(ctx context.Context, v T) error {
+	select {
+	case b.items <- v:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Get removes and returns the oldest value, blocking until one is available
+// or ctx is done, in which case it returns the zero value and ctx.Err().
+func (b *BoundedBuffer[T]) Get(ctx context.Context) (T, error) {
+	select {
+	case v := <-b.items:
+		return v, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Len returns the number of values currently buffered.
+func (b *BoundedBuffer[T]) Len() int {
+	return len(b.items)
+}
+
+// Cap returns the capacity of the buffer.
+func (b *BoundedBuffer[T]) Cap() int {
+	return cap(b.items)
+}
@@ -0,0 +1,62 @@
+package sync2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBoundedBufferProducerConsumer(t *testing.T) {
+	const items = 50
+	b := NewBoundedBuffer[int](2)
+	ctx := context.Background()
+
+	go func() {
+		for i := 0; i < items; i++ {
+			if err := b.Put(ctx, i); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < items; i++ {
+		v, err := b.Get(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != i {
+			t.Fatalf("Get = %d, want %d", v, i)
+		}
+		if n := b.Len(); n > b.Cap() {
+			t.Fatalf("Len %d exceeds Cap %d", n, b.Cap())
+		}
+	}
+}
+
+func TestBoundedBufferPutBlocksWhenFull(t *testing.T) {
+	b := NewBoundedBuffer[int](2)
+	ctx := context.Background()
+	b.Put(ctx, 1)
+	b.Put(ctx, 2)
+
+	tctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := b.Put(tctx, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Put on full buffer = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if b.Len() != 2 || b.Cap() != 2 {
+		t.Fatalf("Len/Cap = %d/%d, want 2/2", b.Len(), b.Cap())
+	}
+}
+
+func TestBoundedBufferGetBlocksWhenEmpty(t *testing.T) {
+	b := NewBoundedBuffer[string](1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if v, err := b.Get(ctx); !errors.Is(err, context.DeadlineExceeded) || v != "" {
+		t.Fatalf("Get on empty buffer = %q, %v", v, err)
+	}
+}
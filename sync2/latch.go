@@ -0,0 +1,62 @@
+package sync2
+
+import (
+	"context"
+	"sync"
+)
+
+// Latch is a one-shot countdown latch: Wait blocks until Done has been
+// called n times. Unlike sync.WaitGroup the count can only go down, and
+// waiting honours a context.
+type Latch struct {
+	mu    sync.Mutex
+	count int
+	done  chan struct{}
+}
+
+// NewLatch returns a latch that opens after n calls to Done. A latch
+// created with zero is already open. It panics if n is negative.
+func NewLatch(n int) *Latch {
+	if n < 0 {
+		panic("sync2: latch count must not be negative")
+	}
+
+	l := &Latch{count: n, done: make(chan struct{})}
+	if n == 0 {
+		close(l.done)
+	}
+	return l
+}
+
+// Done decrements the count, opening the latch when it reaches zero. It
+// panics if the latch is already open.
+func (l *Latch) Done() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.count == 0 {
+		panic("sync2: latch Done called more times than its count")
+	}
+	l.count--
+	if l.count == 0 {
+		close(l.done)
+	}
+}
+
+// Wait blocks until the latch opens or ctx is done, in which case it returns
+// ctx.Err().
+func (l *Latch) Wait(ctx context.Context) error {
+	select {
+	case <-l.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Count returns the number of Done calls still needed to open the latch.
+func (l *Latch) Count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.count
+}
@@ -0,0 +1,61 @@
+package sync2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLatch(t *testing.T) {
+	l := NewLatch(3)
+	errc := make(chan error, 1)
+	go func() { errc <- l.Wait(context.Background()) }()
+
+	for i := 0; i < 2; i++ {
+		l.Done()
+		select {
+		case <-errc:
+			t.Fatalf("Wait returned after %d of 3 Done calls", i+1)
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+	if n := l.Count(); n != 1 {
+		t.Fatalf("Count = %d, want 1", n)
+	}
+
+	l.Done()
+	select {
+	case err := <-errc:
+		if err != nil {
+			t.Fatalf("Wait = %v, want nil", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Wait did not return after the last Done")
+	}
+}
+
+func TestLatchExtraDonePanics(t *testing.T) {
+	l := NewLatch(1)
+	l.Done()
+	defer func() {
+		if recover() == nil {
+			t.Fatal("extra Done did not panic")
+		}
+	}()
+	l.Done()
+}
+
+func TestLatchZeroIsOpen(t *testing.T) {
+	if err := NewLatch(0).Wait(context.Background()); err != nil {
+		t.Fatalf("Wait = %v, want nil", err)
+	}
+}
+
+func TestLatchWaitContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := NewLatch(1).Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait = %v, want context.DeadlineExceeded", err)
+	}
+}
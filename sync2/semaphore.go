@@ -0,0 +1,52 @@
+// Package sync2 provides synchronization primitives that complement the
+// standard sync package.
+package sync2
+
+import "context"
+
+// Semaphore is a counting semaphore backed by a buffered channel. Each
+// acquired permit occupies one slot of the channel.
+type Semaphore struct {
+	slots chan struct{}
+}
+
+// NewSemaphore returns a semaphore with n permits. It panics if n is not
+// positive.
+func NewSemaphore(n int) *Semaphore {
+	if n < 1 {
+		panic("sync2: semaphore size must be positive")
+	}
+	return &Semaphore{slots: make(chan struct{}, n)}
+}
+
+// Acquire blocks until a permit is available or ctx is done, in which case
+// it returns ctx.Err() without holding a permit.
+func (s *Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TryAcquire takes a permit if one is available without blocking and
+// reports whether it did.
+func (s *Semaphore) TryAcquire() bool {
+	select {
+	case s.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Release returns a permit to the semaphore. It panics if no permit is
+// currently held, rather than blocking forever.
+func (s *Semaphore) Release() {
+	select {
+	case <-s.slots:
+	default:
+		panic("sync2: semaphore released more times than acquired")
+	}
+}
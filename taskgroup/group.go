@@ -0,0 +1,54 @@
+// Package taskgroup runs related tasks concurrently and collects the first
+// error, in the spirit of golang.org/x/sync/errgroup.
+package taskgroup
+
+import (
+	"context"
+	"sync"
+)
+
+// Group runs tasks in their own goroutines. The zero value is ready to use
+// and does not cancel anything on error.
+type Group struct {
+	cancel context.CancelCauseFunc
+
+	wg      sync.WaitGroup
+	errOnce sync.Once
+	err     error
+}
+
+// WithContext returns a new Group and a context derived from ctx. The
+// context is cancelled when a task first returns a non-nil error or when
+// Wait returns, whichever happens first.
+func WithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Group{cancel: cancel}, ctx
+}
+
+// Go runs fn in a new goroutine. The first non-nil error returned by any
+// task is recorded and, for a Group from WithContext, cancels its context.
+func (g *Group) Go(fn func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+
+		if err := fn(); err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				if g.cancel != nil {
+					g.cancel(err)
+				}
+			})
+		}
+	}()
+}
+
+// Wait blocks until every task started with Go has returned, then returns
+// the first non-nil error, if any.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(g.err)
+	}
+	return g.err
+}
@@ -0,0 +1,63 @@
+package taskgroup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGroupFirstErrorCancelsContext(t *testing.T) {
+	g, ctx := WithContext(context.Background())
+	boom := errors.New("second task failed")
+
+	g.Go(func() error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	g.Go(func() error {
+		time.Sleep(10 * time.Millisecond)
+		return boom
+	})
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Second):
+			return errors.New("sibling was not cancelled")
+		}
+	})
+
+	if err := g.Wait(); !errors.Is(err, boom) {
+		t.Fatalf("Wait = %v, want %v", err, boom)
+	}
+	if ctx.Err() == nil {
+		t.Fatal("derived context not cancelled")
+	}
+	if cause := context.Cause(ctx); !errors.Is(cause, boom) {
+		t.Fatalf("context cause = %v, want %v", cause, boom)
+	}
+}
+
+func TestGroupWaitCancelsContextOnSuccess(t *testing.T) {
+	g, ctx := WithContext(context.Background())
+	g.Go(func() error { return nil })
+
+	if err := g.Wait(); err != nil {
+		t.Fatalf("Wait = %v, want nil", err)
+	}
+	if ctx.Err() == nil {
+		t.Fatal("context not cancelled after Wait")
+	}
+}
+
+func TestGroupZeroValue(t *testing.T) {
+	var g Group
+	boom := errors.New("boom")
+	g.Go(func() error { return nil })
+	g.Go(func() error { return boom })
+
+	if err := g.Wait(); !errors.Is(err, boom) {
+		t.Fatalf("Wait = %v, want %v", err, boom)
+	}
+}
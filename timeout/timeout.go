@@ -0,0 +1,43 @@
+// Package timeout bounds how long a function call may take.
+package timeout
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTimeout is returned by WithTimeout when the call does not finish in
+// time.
+var ErrTimeout = errors.New("timeout: deadline exceeded")
+
+type result[T any] struct {
+	val T
+	err error
+}
+
+// WithTimeout runs fn in a new goroutine and returns its result, or the
+// zero value and ErrTimeout if d elapses first.
+//
+// Go cannot stop a running goroutine, so after a timeout fn keeps running
+// until it returns on its own; its result is then discarded. The result
+// channel is buffered so that the abandoned goroutine can always complete
+// its send and exit instead of leaking. Functions that can be interrupted
+// should accept a context instead.
+func WithTimeout[T any](d time.Duration, fn func() (T, error)) (T, error) {
+	done := make(chan result[T], 1)
+	go func() {
+		v, err := fn()
+		done <- result[T]{val: v, err: err}
+	}()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case r := <-done:
+		return r.val, r.err
+	case <-timer.C:
+		var zero T
+		return zero, ErrTimeout
+	}
+}
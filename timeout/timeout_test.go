@@ -0,0 +1,31 @@
+package timeout
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWithTimeoutExpires(t *testing.T) {
+	v, err := WithTimeout(10*time.Millisecond, func() (int, error) {
+		time.Sleep(100 * time.Millisecond)
+		return 1, nil
+	})
+	if !errors.Is(err, ErrTimeout) || v != 0 {
+		t.Fatalf("WithTimeout = %d, %v; want 0, ErrTimeout", v, err)
+	}
+}
+
+func TestWithTimeoutReturnsResult(t *testing.T) {
+	boom := errors.New("boom")
+
+	v, err := WithTimeout(time.Second, func() (string, error) { return "ok", nil })
+	if err != nil || v != "ok" {
+		t.Fatalf("WithTimeout = %q, %v; want \"ok\", nil", v, err)
+	}
+
+	_, err = WithTimeout(time.Second, func() (string, error) { return "", boom })
+	if !errors.Is(err, boom) {
+		t.Fatalf("WithTimeout error = %v, want %v", err, boom)
+	}
+}